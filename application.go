@@ -0,0 +1,75 @@
+package dberrors
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+// ApplicationErrorFunc ApplicationErrorFunc export
+// Builds an application specific error from an ApplicationError
+type ApplicationErrorFunc func(*ApplicationError) error
+
+type applicationErrorPrefix struct {
+	prefix string
+	fn     ApplicationErrorFunc
+}
+
+// ApplicationErrorRegistry ApplicationErrorRegistry export
+// Maps ApplicationErrors to application specific errors by code or message prefix.
+type ApplicationErrorRegistry struct {
+	mu       sync.RWMutex
+	codes    map[string]ApplicationErrorFunc
+	prefixes []applicationErrorPrefix
+}
+
+// NewApplicationErrorRegistry NewApplicationErrorRegistry export
+func NewApplicationErrorRegistry() *ApplicationErrorRegistry {
+	return &ApplicationErrorRegistry{
+		codes: make(map[string]ApplicationErrorFunc),
+	}
+}
+
+// RegisterCode RegisterCode export
+// Maps ApplicationErrors with code, e.g. a custom postgres ERRCODE or a mssql THROW number.
+func (r *ApplicationErrorRegistry) RegisterCode(code string, fn ApplicationErrorFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.codes[code] = fn
+}
+
+// RegisterPrefix RegisterPrefix export
+// Maps ApplicationErrors with a message starting with prefix.
+// Codes take precedence over prefixes, prefixes are matched in registration order.
+func (r *ApplicationErrorRegistry) RegisterPrefix(prefix string, fn ApplicationErrorFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.prefixes = append(r.prefixes, applicationErrorPrefix{prefix: prefix, fn: fn})
+}
+
+// Resolve Resolve export
+// Returns the application specific error for err, which is classified with Parse.
+// err is returned unchanged when it is not an ApplicationError or no mapping matches.
+func (r *ApplicationErrorRegistry) Resolve(err error) error {
+	var applicationError *ApplicationError
+	if !errors.As(Parse(err), &applicationError) {
+		return err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if fn, ok := r.codes[applicationError.Code]; ok {
+		return fn(applicationError)
+	}
+
+	for _, p := range r.prefixes {
+		if strings.HasPrefix(applicationError.Message, p.prefix) {
+			return p.fn(applicationError)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,70 @@
+package dberrors_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stackworx-go/dberrors"
+	_ "github.com/stackworx-go/dberrors/parser/sqlite"
+	"github.com/stretchr/testify/assert"
+)
+
+type insufficientFundsError struct {
+	message string
+}
+
+func (e *insufficientFundsError) Error() string {
+	return e.message
+}
+
+var errAccountFrozen = errors.New("account frozen")
+
+func TestApplicationErrorRegistry(t *testing.T) {
+	db := openSqlite(t)
+	defer db.Close()
+
+	for _, statement := range []string{
+		"create table transfers (amount integer)",
+		`create trigger check_amount before insert on transfers
+begin
+    select raise(abort, 'insufficient funds: balance 10') where new.amount > 10;
+    select raise(abort, 'account frozen') where new.amount < 0;
+    select raise(abort, 'other') where new.amount = 0;
+end`,
+	} {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	registry := dberrors.NewApplicationErrorRegistry()
+	registry.RegisterPrefix("insufficient funds", func(e *dberrors.ApplicationError) error {
+		return &insufficientFundsError{message: e.Message}
+	})
+	registry.RegisterPrefix("account frozen", func(*dberrors.ApplicationError) error {
+		return errAccountFrozen
+	})
+
+	_, err := db.Exec("insert into transfers (amount) values (11)")
+
+	var applicationError *dberrors.ApplicationError
+	assert.True(t, errors.As(dberrors.Parse(err), &applicationError))
+	assert.Equal(t, "1811", applicationError.Code)
+	assert.Equal(t, "insufficient funds: balance 10", applicationError.Message)
+
+	var fundsError *insufficientFundsError
+	assert.True(t, errors.As(registry.Resolve(err), &fundsError))
+	assert.True(t, strings.HasSuffix(fundsError.message, "balance 10"))
+
+	_, err = db.Exec("insert into transfers (amount) values (-1)")
+	assert.Equal(t, errAccountFrozen, registry.Resolve(err))
+
+	_, err = db.Exec("insert into transfers (amount) values (0)")
+	assert.Equal(t, err, registry.Resolve(err))
+
+	registry.RegisterCode("1811", func(*dberrors.ApplicationError) error {
+		return errAccountFrozen
+	})
+	assert.Equal(t, errAccountFrozen, registry.Resolve(err))
+}
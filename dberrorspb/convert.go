@@ -0,0 +1,235 @@
+// Package dberrorspb contains the protobuf representation of standardized database errors,
+// e.g. to exchange them between services over gRPC or a message queue.
+package dberrorspb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative dberrors.proto
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/stackworx-go/dberrors"
+)
+
+type standardized interface {
+	error
+	Kind() dberrors.Kind
+	Dialect() dberrors.Dialect
+	Diagnostics() dberrors.Diagnostics
+	Unwrap() error
+}
+
+// ToProto ToProto export
+// Converts the first standardized error in err's chain, nil is returned when there is none.
+// The native error is not preserved, only its message.
+func ToProto(err error) *DatabaseError {
+	var s standardized
+	if !errors.As(err, &s) {
+		return nil
+	}
+
+	diagnostics := s.Diagnostics()
+
+	pb := &DatabaseError{
+		Kind:        Kind(Kind_value["KIND_"+string(s.Kind())]),
+		Dialect:     Dialect(Dialect_value["DIALECT_"+string(s.Dialect())]),
+		Code:        diagnostics.Code,
+		Diagnostics: diagnosticsToProto(diagnostics),
+	}
+
+	if native := s.Unwrap(); native != nil {
+		pb.Error = native.Error()
+	}
+
+	switch e := s.(type) {
+	case *dberrors.DataError:
+		pb.Reason = DataErrorReason(DataErrorReason_value["DATA_ERROR_REASON_"+string(e.Reason)])
+		pb.Table, pb.Column, pb.Schema, pb.Row = e.Table, e.Column, e.Schema, int32(e.Row)
+	case *dberrors.CheckViolationError:
+		pb.Table, pb.Constraint = e.Table, e.Constraint
+	case *dberrors.ForeignKeyViolationError:
+		pb.Table, pb.Constraint, pb.Schema = e.Table, e.Constraint, e.Schema
+	case *dberrors.NotNullViolationError:
+		pb.Table, pb.Column, pb.Schema = e.Table, e.Column, e.Schema
+	case *dberrors.UniqueViolationError:
+		pb.Table, pb.Column, pb.Constraint, pb.Schema = e.Table, e.Column, e.Constraint, e.Schema
+	case *dberrors.ConnectionError:
+		pb.MaybeExecuted = e.MaybeExecuted
+	case *dberrors.TimeoutError:
+		pb.Source = Source(Source_value["SOURCE_"+string(e.Source)])
+	case *dberrors.CanceledError:
+		pb.Source = Source(Source_value["SOURCE_"+string(e.Source)])
+	case *dberrors.PermissionDeniedError:
+		pb.User, pb.Privilege, pb.ObjectType, pb.Object, pb.Schema = e.User, e.Privilege, e.ObjectType, e.Object, e.Schema
+	case *dberrors.AuthenticationError:
+		pb.User = e.User
+	case *dberrors.UndefinedObjectError:
+		pb.ObjectType, pb.Name, pb.Schema = e.ObjectType, e.Name, e.Schema
+	case *dberrors.SyntaxError:
+		pb.Token, pb.Line, pb.Position = e.Token, int32(e.Line), int32(e.Position)
+	case *dberrors.ResourceExhaustedError:
+		pb.Resource = e.Resource
+	case *dberrors.ApplicationError:
+		// The raised message may contain values
+		if dberrors.ValuesShown() {
+			pb.Message = e.Message
+		}
+	}
+
+	return pb
+}
+
+// FromProto FromProto export
+// Rebuilds the typed standardized error, e.g. *dberrors.UniqueViolationError for KIND_UNIQUE_VIOLATION.
+// Decoded errors unwrap to an error with the message of the native error.
+func FromProto(pb *DatabaseError) (error, error) {
+	if pb == nil {
+		return nil, nil
+	}
+
+	var native error
+	if pb.Error != "" {
+		native = errors.New(pb.Error)
+	}
+
+	diagnostics := diagnosticsFromProto(pb.Diagnostics)
+	diagnostics.Code = pb.Code
+
+	dbError := dberrors.NewDbErrorWithDiagnostics(native, dialectFromProto(pb.Dialect), diagnostics)
+
+	switch pb.Kind {
+	case Kind_KIND_DATA_ERROR:
+		return &dberrors.DataError{
+			Reason:  dataErrorReasonFromProto(pb.Reason),
+			Table:   pb.Table,
+			Column:  pb.Column,
+			Schema:  pb.Schema,
+			Row:     int(pb.Row),
+			DbError: dbError,
+		}, nil
+	case Kind_KIND_CHECK_VIOLATION:
+		return &dberrors.CheckViolationError{Table: pb.Table, Constraint: pb.Constraint, DbError: dbError}, nil
+	case Kind_KIND_FOREIGN_KEY_VIOLATION:
+		return &dberrors.ForeignKeyViolationError{Table: pb.Table, Constraint: pb.Constraint, Schema: pb.Schema, DbError: dbError}, nil
+	case Kind_KIND_NOT_NULL_VIOLATION:
+		return &dberrors.NotNullViolationError{Table: pb.Table, Column: pb.Column, Schema: pb.Schema, DbError: dbError}, nil
+	case Kind_KIND_UNIQUE_VIOLATION:
+		return &dberrors.UniqueViolationError{
+			Table:      pb.Table,
+			Column:     pb.Column,
+			Constraint: pb.Constraint,
+			Schema:     pb.Schema,
+			DbError:    dbError,
+		}, nil
+	case Kind_KIND_DEADLOCK:
+		return &dberrors.DeadlockError{DbError: dbError}, nil
+	case Kind_KIND_SERIALIZATION_FAILURE:
+		return &dberrors.SerializationFailureError{DbError: dbError}, nil
+	case Kind_KIND_LOCK_TIMEOUT:
+		return &dberrors.LockTimeoutError{DbError: dbError}, nil
+	case Kind_KIND_CONNECTION_ERROR:
+		return &dberrors.ConnectionError{MaybeExecuted: pb.MaybeExecuted, DbError: dbError}, nil
+	case Kind_KIND_TIMEOUT:
+		return &dberrors.TimeoutError{Source: sourceFromProto(pb.Source), DbError: dbError}, nil
+	case Kind_KIND_CANCELED:
+		return &dberrors.CanceledError{Source: sourceFromProto(pb.Source), DbError: dbError}, nil
+	case Kind_KIND_PERMISSION_DENIED:
+		return &dberrors.PermissionDeniedError{
+			User:       pb.User,
+			Privilege:  pb.Privilege,
+			ObjectType: pb.ObjectType,
+			Object:     pb.Object,
+			Schema:     pb.Schema,
+			DbError:    dbError,
+		}, nil
+	case Kind_KIND_AUTHENTICATION_ERROR:
+		return &dberrors.AuthenticationError{User: pb.User, DbError: dbError}, nil
+	case Kind_KIND_UNDEFINED_OBJECT:
+		return &dberrors.UndefinedObjectError{ObjectType: pb.ObjectType, Name: pb.Name, Schema: pb.Schema, DbError: dbError}, nil
+	case Kind_KIND_SYNTAX_ERROR:
+		return &dberrors.SyntaxError{Token: pb.Token, Line: int(pb.Line), Position: int(pb.Position), DbError: dbError}, nil
+	case Kind_KIND_RESOURCE_EXHAUSTED:
+		return &dberrors.ResourceExhaustedError{Resource: pb.Resource, DbError: dbError}, nil
+	case Kind_KIND_READ_ONLY:
+		return &dberrors.ReadOnlyError{DbError: dbError}, nil
+	case Kind_KIND_ABORTED_TRANSACTION:
+		return &dberrors.AbortedTransactionError{DbError: dbError}, nil
+	case Kind_KIND_APPLICATION_ERROR:
+		return &dberrors.ApplicationError{Code: pb.Code, Message: pb.Message, DbError: dbError}, nil
+	}
+
+	return nil, fmt.Errorf("dberrorspb: unknown error kind %v", pb.Kind)
+}
+
+func diagnosticsToProto(diagnostics dberrors.Diagnostics) *Diagnostics {
+	if diagnostics == (dberrors.Diagnostics{Code: diagnostics.Code}) {
+		return nil
+	}
+
+	return &Diagnostics{
+		Severity:         diagnostics.Severity,
+		Hint:             diagnostics.Hint,
+		Position:         int32(diagnostics.Position),
+		InternalPosition: int32(diagnostics.InternalPosition),
+		InternalQuery:    diagnostics.InternalQuery,
+		Where:            diagnostics.Where,
+		File:             diagnostics.File,
+		Line:             int32(diagnostics.Line),
+		Routine:          diagnostics.Routine,
+		Procedure:        diagnostics.Procedure,
+		ProcedureLine:    int32(diagnostics.ProcedureLine),
+		Server:           diagnostics.Server,
+		State:            uint32(diagnostics.State),
+		Class:            uint32(diagnostics.Class),
+		Detail:           diagnostics.Detail,
+	}
+}
+
+func diagnosticsFromProto(pb *Diagnostics) dberrors.Diagnostics {
+	if pb == nil {
+		return dberrors.Diagnostics{}
+	}
+
+	return dberrors.Diagnostics{
+		Severity:         pb.Severity,
+		Hint:             pb.Hint,
+		Position:         int(pb.Position),
+		InternalPosition: int(pb.InternalPosition),
+		InternalQuery:    pb.InternalQuery,
+		Where:            pb.Where,
+		File:             pb.File,
+		Line:             int(pb.Line),
+		Routine:          pb.Routine,
+		Procedure:        pb.Procedure,
+		ProcedureLine:    int(pb.ProcedureLine),
+		Server:           pb.Server,
+		State:            uint8(pb.State),
+		Class:            uint8(pb.Class),
+		Detail:           pb.Detail,
+	}
+}
+
+func dialectFromProto(d Dialect) dberrors.Dialect {
+	if d == Dialect_DIALECT_UNSPECIFIED {
+		return ""
+	}
+
+	return dberrors.Dialect(strings.TrimPrefix(d.String(), "DIALECT_"))
+}
+
+func sourceFromProto(s Source) dberrors.Source {
+	if s == Source_SOURCE_UNSPECIFIED {
+		return ""
+	}
+
+	return dberrors.Source(strings.TrimPrefix(s.String(), "SOURCE_"))
+}
+
+func dataErrorReasonFromProto(r DataErrorReason) dberrors.DataErrorReason {
+	if r == DataErrorReason_DATA_ERROR_REASON_UNSPECIFIED {
+		return ""
+	}
+
+	return dberrors.DataErrorReason(strings.TrimPrefix(r.String(), "DATA_ERROR_REASON_"))
+}
@@ -0,0 +1,102 @@
+package dberrorspb_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lib/pq"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/dberrorspb"
+	"github.com/stackworx-go/dberrors/parser/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundTrip(t *testing.T) {
+	native := errors.New("native")
+	dbError := dberrors.NewDbErrorWithDiagnostics(native, dberrors.POSTGRES, dberrors.Diagnostics{
+		Code:     "23505",
+		Severity: "ERROR",
+		Position: 12,
+	})
+
+	for _, err := range []error{
+		&dberrors.DataError{Reason: dberrors.InvalidDatetime, Table: "users", Column: "born_at", Schema: "public", Row: 3, DbError: dberrors.NewDbError(native, dberrors.MYSQL)},
+		&dberrors.CheckViolationError{Table: "users", Constraint: "users_age_check", DbError: dbError},
+		&dberrors.ForeignKeyViolationError{Table: "orders", Constraint: "orders_user_id_fkey", Schema: "public", DbError: dbError},
+		&dberrors.NotNullViolationError{Table: "users", Column: "email", Schema: "public", DbError: dbError},
+		&dberrors.UniqueViolationError{Table: "users", Column: "email", Constraint: "users_email_key", Schema: "public", DbError: dbError},
+		&dberrors.DeadlockError{DbError: dberrors.NewDbError(native, dberrors.MSSQL)},
+		&dberrors.SerializationFailureError{DbError: dbError},
+		&dberrors.LockTimeoutError{DbError: dbError},
+		&dberrors.ConnectionError{MaybeExecuted: true, DbError: dberrors.NewDbError(native, dberrors.MYSQL)},
+		&dberrors.TimeoutError{Source: dberrors.ServerSource, DbError: dbError},
+		&dberrors.CanceledError{Source: dberrors.ClientSource, DbError: dberrors.NewDbError(errors.New("context canceled"), dberrors.SQLITE3)},
+		&dberrors.PermissionDeniedError{User: "app", Privilege: "SELECT", ObjectType: "table", Object: "users", Schema: "public", DbError: dbError},
+		&dberrors.AuthenticationError{User: "app", DbError: dbError},
+		&dberrors.UndefinedObjectError{ObjectType: "column", Name: "emial", Schema: "public", DbError: dbError},
+		&dberrors.SyntaxError{Token: "form", Line: 1, Position: 10, DbError: dbError},
+		&dberrors.ResourceExhaustedError{Resource: "disk", DbError: dbError},
+		&dberrors.ReadOnlyError{DbError: dbError},
+		&dberrors.AbortedTransactionError{DbError: dbError},
+		&dberrors.ApplicationError{Code: "P0001", DbError: dberrors.NewDbErrorWithDiagnostics(native, dberrors.POSTGRES, dberrors.Diagnostics{Code: "P0001"})},
+	} {
+		t.Run(string(dberrors.KindOf(err)), func(t *testing.T) {
+			data, marshalErr := proto.Marshal(dberrorspb.ToProto(fmt.Errorf("wrapped: %w", err)))
+			assert.NoError(t, marshalErr)
+
+			var pb dberrorspb.DatabaseError
+			assert.NoError(t, proto.Unmarshal(data, &pb))
+
+			decoded, decodeErr := dberrorspb.FromProto(&pb)
+			assert.NoError(t, decodeErr)
+			assert.Equal(t, err, decoded)
+
+			// Round trips with the JSON form
+			expected, _ := json.Marshal(err)
+			actual, _ := json.Marshal(decoded)
+			assert.JSONEq(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestToProto(t *testing.T) {
+	pb := dberrorspb.ToProto(&dberrors.UniqueViolationError{
+		Table:      "users",
+		Constraint: "users_email_key",
+		DbError:    dberrors.NewDbErrorWithDiagnostics(errors.New("pq: duplicate key value"), dberrors.POSTGRES, dberrors.Diagnostics{Code: "23505"}),
+	})
+
+	assert.True(t, proto.Equal(&dberrorspb.DatabaseError{
+		Kind:       dberrorspb.Kind_KIND_UNIQUE_VIOLATION,
+		Dialect:    dberrorspb.Dialect_DIALECT_POSTGRES,
+		Code:       "23505",
+		Error:      "pq: duplicate key value",
+		Table:      "users",
+		Constraint: "users_email_key",
+	}, pb), "DatabaseError %v", pb)
+
+	assert.Nil(t, dberrorspb.ToProto(errors.New("boom")))
+}
+
+func TestToProtoApplicationMessageRedacted(t *testing.T) {
+	err := postgres.Parse(&pq.Error{Severity: "ERROR", Code: "P0001", Message: "jane@example.com is blocked"})
+
+	pb := dberrorspb.ToProto(err)
+	assert.Equal(t, "", pb.Message)
+	assert.Equal(t, "pq: ?", pb.Error)
+
+	dberrors.ShowValues(true)
+	defer dberrors.ShowValues(false)
+
+	pb = dberrorspb.ToProto(err)
+	assert.Equal(t, "jane@example.com is blocked", pb.Message)
+	assert.Equal(t, "pq: jane@example.com is blocked", pb.Error)
+}
+
+func TestFromProtoUnknownKind(t *testing.T) {
+	_, err := dberrorspb.FromProto(&dberrorspb.DatabaseError{})
+	assert.EqualError(t, err, "dberrorspb: unknown error kind KIND_UNSPECIFIED")
+}
@@ -0,0 +1,925 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        (unknown)
+// source: dberrors.proto
+
+package dberrorspb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// Kind of a standardized error, the values match dberrors.Kind prefixed with KIND_
+type Kind int32
+
+const (
+	Kind_KIND_UNSPECIFIED           Kind = 0
+	Kind_KIND_DATA_ERROR            Kind = 1
+	Kind_KIND_CHECK_VIOLATION       Kind = 2
+	Kind_KIND_FOREIGN_KEY_VIOLATION Kind = 3
+	Kind_KIND_NOT_NULL_VIOLATION    Kind = 4
+	Kind_KIND_UNIQUE_VIOLATION      Kind = 5
+	Kind_KIND_DEADLOCK              Kind = 6
+	Kind_KIND_SERIALIZATION_FAILURE Kind = 7
+	Kind_KIND_LOCK_TIMEOUT          Kind = 8
+	Kind_KIND_CONNECTION_ERROR      Kind = 9
+	Kind_KIND_TIMEOUT               Kind = 10
+	Kind_KIND_CANCELED              Kind = 11
+	Kind_KIND_PERMISSION_DENIED     Kind = 12
+	Kind_KIND_AUTHENTICATION_ERROR  Kind = 13
+	Kind_KIND_UNDEFINED_OBJECT      Kind = 14
+	Kind_KIND_SYNTAX_ERROR          Kind = 15
+	Kind_KIND_RESOURCE_EXHAUSTED    Kind = 16
+	Kind_KIND_READ_ONLY             Kind = 17
+	Kind_KIND_ABORTED_TRANSACTION   Kind = 18
+	Kind_KIND_APPLICATION_ERROR     Kind = 19
+)
+
+// Enum value maps for Kind.
+var (
+	Kind_name = map[int32]string{
+		0:  "KIND_UNSPECIFIED",
+		1:  "KIND_DATA_ERROR",
+		2:  "KIND_CHECK_VIOLATION",
+		3:  "KIND_FOREIGN_KEY_VIOLATION",
+		4:  "KIND_NOT_NULL_VIOLATION",
+		5:  "KIND_UNIQUE_VIOLATION",
+		6:  "KIND_DEADLOCK",
+		7:  "KIND_SERIALIZATION_FAILURE",
+		8:  "KIND_LOCK_TIMEOUT",
+		9:  "KIND_CONNECTION_ERROR",
+		10: "KIND_TIMEOUT",
+		11: "KIND_CANCELED",
+		12: "KIND_PERMISSION_DENIED",
+		13: "KIND_AUTHENTICATION_ERROR",
+		14: "KIND_UNDEFINED_OBJECT",
+		15: "KIND_SYNTAX_ERROR",
+		16: "KIND_RESOURCE_EXHAUSTED",
+		17: "KIND_READ_ONLY",
+		18: "KIND_ABORTED_TRANSACTION",
+		19: "KIND_APPLICATION_ERROR",
+	}
+	Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED":           0,
+		"KIND_DATA_ERROR":            1,
+		"KIND_CHECK_VIOLATION":       2,
+		"KIND_FOREIGN_KEY_VIOLATION": 3,
+		"KIND_NOT_NULL_VIOLATION":    4,
+		"KIND_UNIQUE_VIOLATION":      5,
+		"KIND_DEADLOCK":              6,
+		"KIND_SERIALIZATION_FAILURE": 7,
+		"KIND_LOCK_TIMEOUT":          8,
+		"KIND_CONNECTION_ERROR":      9,
+		"KIND_TIMEOUT":               10,
+		"KIND_CANCELED":              11,
+		"KIND_PERMISSION_DENIED":     12,
+		"KIND_AUTHENTICATION_ERROR":  13,
+		"KIND_UNDEFINED_OBJECT":      14,
+		"KIND_SYNTAX_ERROR":          15,
+		"KIND_RESOURCE_EXHAUSTED":    16,
+		"KIND_READ_ONLY":             17,
+		"KIND_ABORTED_TRANSACTION":   18,
+		"KIND_APPLICATION_ERROR":     19,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_dberrors_proto_enumTypes[0].Descriptor()
+}
+
+func (Kind) Type() protoreflect.EnumType {
+	return &file_dberrors_proto_enumTypes[0]
+}
+
+func (x Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Kind.Descriptor instead.
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return file_dberrors_proto_rawDescGZIP(), []int{0}
+}
+
+type Dialect int32
+
+const (
+	Dialect_DIALECT_UNSPECIFIED Dialect = 0
+	Dialect_DIALECT_MYSQL       Dialect = 1
+	Dialect_DIALECT_POSTGRES    Dialect = 2
+	Dialect_DIALECT_SQLITE3     Dialect = 3
+	Dialect_DIALECT_MSSQL       Dialect = 4
+)
+
+// Enum value maps for Dialect.
+var (
+	Dialect_name = map[int32]string{
+		0: "DIALECT_UNSPECIFIED",
+		1: "DIALECT_MYSQL",
+		2: "DIALECT_POSTGRES",
+		3: "DIALECT_SQLITE3",
+		4: "DIALECT_MSSQL",
+	}
+	Dialect_value = map[string]int32{
+		"DIALECT_UNSPECIFIED": 0,
+		"DIALECT_MYSQL":       1,
+		"DIALECT_POSTGRES":    2,
+		"DIALECT_SQLITE3":     3,
+		"DIALECT_MSSQL":       4,
+	}
+)
+
+func (x Dialect) Enum() *Dialect {
+	p := new(Dialect)
+	*p = x
+	return p
+}
+
+func (x Dialect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Dialect) Descriptor() protoreflect.EnumDescriptor {
+	return file_dberrors_proto_enumTypes[1].Descriptor()
+}
+
+func (Dialect) Type() protoreflect.EnumType {
+	return &file_dberrors_proto_enumTypes[1]
+}
+
+func (x Dialect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Dialect.Descriptor instead.
+func (Dialect) EnumDescriptor() ([]byte, []int) {
+	return file_dberrors_proto_rawDescGZIP(), []int{1}
+}
+
+type Source int32
+
+const (
+	Source_SOURCE_UNSPECIFIED Source = 0
+	Source_SOURCE_SERVER      Source = 1
+	Source_SOURCE_CLIENT      Source = 2
+)
+
+// Enum value maps for Source.
+var (
+	Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "SOURCE_SERVER",
+		2: "SOURCE_CLIENT",
+	}
+	Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED": 0,
+		"SOURCE_SERVER":      1,
+		"SOURCE_CLIENT":      2,
+	}
+)
+
+func (x Source) Enum() *Source {
+	p := new(Source)
+	*p = x
+	return p
+}
+
+func (x Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_dberrors_proto_enumTypes[2].Descriptor()
+}
+
+func (Source) Type() protoreflect.EnumType {
+	return &file_dberrors_proto_enumTypes[2]
+}
+
+func (x Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Source.Descriptor instead.
+func (Source) EnumDescriptor() ([]byte, []int) {
+	return file_dberrors_proto_rawDescGZIP(), []int{2}
+}
+
+type DataErrorReason int32
+
+const (
+	DataErrorReason_DATA_ERROR_REASON_UNSPECIFIED                 DataErrorReason = 0
+	DataErrorReason_DATA_ERROR_REASON_STRING_TRUNCATION           DataErrorReason = 1
+	DataErrorReason_DATA_ERROR_REASON_NUMERIC_OUT_OF_RANGE        DataErrorReason = 2
+	DataErrorReason_DATA_ERROR_REASON_INVALID_DATETIME            DataErrorReason = 3
+	DataErrorReason_DATA_ERROR_REASON_INVALID_TEXT_REPRESENTATION DataErrorReason = 4
+	DataErrorReason_DATA_ERROR_REASON_DIVISION_BY_ZERO            DataErrorReason = 5
+	DataErrorReason_DATA_ERROR_REASON_INVALID_ENUM_VALUE          DataErrorReason = 6
+	DataErrorReason_DATA_ERROR_REASON_INVALID_JSON                DataErrorReason = 7
+)
+
+// Enum value maps for DataErrorReason.
+var (
+	DataErrorReason_name = map[int32]string{
+		0: "DATA_ERROR_REASON_UNSPECIFIED",
+		1: "DATA_ERROR_REASON_STRING_TRUNCATION",
+		2: "DATA_ERROR_REASON_NUMERIC_OUT_OF_RANGE",
+		3: "DATA_ERROR_REASON_INVALID_DATETIME",
+		4: "DATA_ERROR_REASON_INVALID_TEXT_REPRESENTATION",
+		5: "DATA_ERROR_REASON_DIVISION_BY_ZERO",
+		6: "DATA_ERROR_REASON_INVALID_ENUM_VALUE",
+		7: "DATA_ERROR_REASON_INVALID_JSON",
+	}
+	DataErrorReason_value = map[string]int32{
+		"DATA_ERROR_REASON_UNSPECIFIED":                 0,
+		"DATA_ERROR_REASON_STRING_TRUNCATION":           1,
+		"DATA_ERROR_REASON_NUMERIC_OUT_OF_RANGE":        2,
+		"DATA_ERROR_REASON_INVALID_DATETIME":            3,
+		"DATA_ERROR_REASON_INVALID_TEXT_REPRESENTATION": 4,
+		"DATA_ERROR_REASON_DIVISION_BY_ZERO":            5,
+		"DATA_ERROR_REASON_INVALID_ENUM_VALUE":          6,
+		"DATA_ERROR_REASON_INVALID_JSON":                7,
+	}
+)
+
+func (x DataErrorReason) Enum() *DataErrorReason {
+	p := new(DataErrorReason)
+	*p = x
+	return p
+}
+
+func (x DataErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_dberrors_proto_enumTypes[3].Descriptor()
+}
+
+func (DataErrorReason) Type() protoreflect.EnumType {
+	return &file_dberrors_proto_enumTypes[3]
+}
+
+func (x DataErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataErrorReason.Descriptor instead.
+func (DataErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_dberrors_proto_rawDescGZIP(), []int{3}
+}
+
+// Additional diagnostic information reported by the database, see dberrors.Diagnostics
+type Diagnostics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Severity         string `protobuf:"bytes,1,opt,name=severity,proto3" json:"severity,omitempty"`
+	Hint             string `protobuf:"bytes,2,opt,name=hint,proto3" json:"hint,omitempty"`
+	Position         int32  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	InternalPosition int32  `protobuf:"varint,4,opt,name=internal_position,json=internalPosition,proto3" json:"internal_position,omitempty"`
+	InternalQuery    string `protobuf:"bytes,5,opt,name=internal_query,json=internalQuery,proto3" json:"internal_query,omitempty"`
+	Where            string `protobuf:"bytes,6,opt,name=where,proto3" json:"where,omitempty"`
+	File             string `protobuf:"bytes,7,opt,name=file,proto3" json:"file,omitempty"`
+	Line             int32  `protobuf:"varint,8,opt,name=line,proto3" json:"line,omitempty"`
+	Routine          string `protobuf:"bytes,9,opt,name=routine,proto3" json:"routine,omitempty"`
+	Procedure        string `protobuf:"bytes,10,opt,name=procedure,proto3" json:"procedure,omitempty"`
+	ProcedureLine    int32  `protobuf:"varint,11,opt,name=procedure_line,json=procedureLine,proto3" json:"procedure_line,omitempty"`
+	Server           string `protobuf:"bytes,12,opt,name=server,proto3" json:"server,omitempty"`
+	State            uint32 `protobuf:"varint,13,opt,name=state,proto3" json:"state,omitempty"`
+	Class            uint32 `protobuf:"varint,14,opt,name=class,proto3" json:"class,omitempty"`
+	Detail           string `protobuf:"bytes,15,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *Diagnostics) Reset() {
+	*x = Diagnostics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dberrors_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Diagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostics) ProtoMessage() {}
+
+func (x *Diagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_dberrors_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostics.ProtoReflect.Descriptor instead.
+func (*Diagnostics) Descriptor() ([]byte, []int) {
+	return file_dberrors_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Diagnostics) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetInternalPosition() int32 {
+	if x != nil {
+		return x.InternalPosition
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetInternalQuery() string {
+	if x != nil {
+		return x.InternalQuery
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetWhere() string {
+	if x != nil {
+		return x.Where
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetRoutine() string {
+	if x != nil {
+		return x.Routine
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetProcedure() string {
+	if x != nil {
+		return x.Procedure
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetProcedureLine() int32 {
+	if x != nil {
+		return x.ProcedureLine
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetState() uint32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetClass() uint32 {
+	if x != nil {
+		return x.Class
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// A standardized database error.
+// Fields which do not apply to the kind are left empty.
+type DatabaseError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    Kind    `protobuf:"varint,1,opt,name=kind,proto3,enum=dberrors.v1.Kind" json:"kind,omitempty"`
+	Dialect Dialect `protobuf:"varint,2,opt,name=dialect,proto3,enum=dberrors.v1.Dialect" json:"dialect,omitempty"`
+	// Native error code: SQLSTATE (postgres), error number (mysql and mssql) or extended result code (sqlite)
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// Message of the native error
+	Error       string       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Diagnostics *Diagnostics `protobuf:"bytes,5,opt,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// Constraint violations and data errors
+	Table      string          `protobuf:"bytes,6,opt,name=table,proto3" json:"table,omitempty"`
+	Column     string          `protobuf:"bytes,7,opt,name=column,proto3" json:"column,omitempty"`
+	Constraint string          `protobuf:"bytes,8,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	Schema     string          `protobuf:"bytes,9,opt,name=schema,proto3" json:"schema,omitempty"`
+	Reason     DataErrorReason `protobuf:"varint,10,opt,name=reason,proto3,enum=dberrors.v1.DataErrorReason" json:"reason,omitempty"`
+	Row        int32           `protobuf:"varint,11,opt,name=row,proto3" json:"row,omitempty"`
+	// Connection errors
+	MaybeExecuted bool `protobuf:"varint,12,opt,name=maybe_executed,json=maybeExecuted,proto3" json:"maybe_executed,omitempty"`
+	// Timeout and canceled errors
+	Source Source `protobuf:"varint,13,opt,name=source,proto3,enum=dberrors.v1.Source" json:"source,omitempty"`
+	// Permission denied and authentication errors
+	User       string `protobuf:"bytes,14,opt,name=user,proto3" json:"user,omitempty"`
+	Privilege  string `protobuf:"bytes,15,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	ObjectType string `protobuf:"bytes,16,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	Object     string `protobuf:"bytes,17,opt,name=object,proto3" json:"object,omitempty"`
+	// Undefined object errors
+	Name string `protobuf:"bytes,18,opt,name=name,proto3" json:"name,omitempty"`
+	// Syntax errors
+	Token    string `protobuf:"bytes,19,opt,name=token,proto3" json:"token,omitempty"`
+	Line     int32  `protobuf:"varint,20,opt,name=line,proto3" json:"line,omitempty"`
+	Position int32  `protobuf:"varint,21,opt,name=position,proto3" json:"position,omitempty"`
+	// Resource exhausted errors
+	Resource string `protobuf:"bytes,22,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Application errors, the code is the native code
+	Message string `protobuf:"bytes,23,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DatabaseError) Reset() {
+	*x = DatabaseError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dberrors_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseError) ProtoMessage() {}
+
+func (x *DatabaseError) ProtoReflect() protoreflect.Message {
+	mi := &file_dberrors_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseError.ProtoReflect.Descriptor instead.
+func (*DatabaseError) Descriptor() ([]byte, []int) {
+	return file_dberrors_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DatabaseError) GetKind() Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Kind_KIND_UNSPECIFIED
+}
+
+func (x *DatabaseError) GetDialect() Dialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return Dialect_DIALECT_UNSPECIFIED
+}
+
+func (x *DatabaseError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetDiagnostics() *Diagnostics {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *DatabaseError) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetConstraint() string {
+	if x != nil {
+		return x.Constraint
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetReason() DataErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DataErrorReason_DATA_ERROR_REASON_UNSPECIFIED
+}
+
+func (x *DatabaseError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *DatabaseError) GetMaybeExecuted() bool {
+	if x != nil {
+		return x.MaybeExecuted
+	}
+	return false
+}
+
+func (x *DatabaseError) GetSource() Source {
+	if x != nil {
+		return x.Source
+	}
+	return Source_SOURCE_UNSPECIFIED
+}
+
+func (x *DatabaseError) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetPrivilege() string {
+	if x != nil {
+		return x.Privilege
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *DatabaseError) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *DatabaseError) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *DatabaseError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_dberrors_proto protoreflect.FileDescriptor
+
+var file_dberrors_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x64, 0x62, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0b, 0x64, 0x62, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x22, 0xa6, 0x03,
+	0x0a, 0x0b, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x77, 0x68,
+	0x65, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75,
+	0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x64,
+	0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65,
+	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0xc9, 0x05, 0x0a, 0x0d, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x64, 0x62, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x2e, 0x0a, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x64, 0x62, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x64, 0x62, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x34, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x62,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x72, 0x6f, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x61, 0x79,
+	0x62, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x64, 0x62, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2a, 0x85, 0x04, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02,
+	0x12, 0x1e, 0x0a, 0x1a, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x45, 0x49, 0x47, 0x4e,
+	0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03,
+	0x12, 0x1b, 0x0a, 0x17, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4e, 0x55, 0x4c,
+	0x4c, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x19, 0x0a,
+	0x15, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x56, 0x49, 0x4f,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54,
+	0x10, 0x08, 0x12, 0x19, 0x0a, 0x15, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x09, 0x12, 0x10, 0x0a,
+	0x0c, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0a, 0x12,
+	0x11, 0x0a, 0x0d, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44,
+	0x10, 0x0b, 0x12, 0x1a, 0x0a, 0x16, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x1d,
+	0x0a, 0x19, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0d, 0x12, 0x19, 0x0a,
+	0x15, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f,
+	0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x0e, 0x12, 0x15, 0x0a, 0x11, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x53, 0x59, 0x4e, 0x54, 0x41, 0x58, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0f, 0x12,
+	0x1b, 0x0a, 0x17, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x10, 0x12, 0x12, 0x0a, 0x0e,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x11,
+	0x12, 0x1c, 0x0a, 0x18, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x12, 0x12, 0x1a,
+	0x0a, 0x16, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x13, 0x2a, 0x73, 0x0a, 0x07, 0x44, 0x69,
+	0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x53,
+	0x54, 0x47, 0x52, 0x45, 0x53, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x41, 0x4c, 0x45,
+	0x43, 0x54, 0x5f, 0x53, 0x51, 0x4c, 0x49, 0x54, 0x45, 0x33, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d,
+	0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x53, 0x53, 0x51, 0x4c, 0x10, 0x04, 0x2a,
+	0x46, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56,
+	0x45, 0x52, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43,
+	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0xda, 0x02, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27,
+	0x0a, 0x23, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x2a, 0x0a, 0x26, 0x44, 0x41, 0x54, 0x41, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x55, 0x4d,
+	0x45, 0x52, 0x49, 0x43, 0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52, 0x41, 0x4e, 0x47,
+	0x45, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x44, 0x41, 0x54, 0x45, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x31, 0x0a, 0x2d, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x52, 0x45,
+	0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x26,
+	0x0a, 0x22, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x59, 0x5f,
+	0x5a, 0x45, 0x52, 0x4f, 0x10, 0x05, 0x12, 0x28, 0x0a, 0x24, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x06,
+	0x12, 0x22, 0x0a, 0x1e, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4a, 0x53,
+	0x4f, 0x4e, 0x10, 0x07, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x77, 0x6f, 0x72, 0x78, 0x2d, 0x67, 0x6f, 0x2f,
+	0x64, 0x62, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x64, 0x62, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dberrors_proto_rawDescOnce sync.Once
+	file_dberrors_proto_rawDescData = file_dberrors_proto_rawDesc
+)
+
+func file_dberrors_proto_rawDescGZIP() []byte {
+	file_dberrors_proto_rawDescOnce.Do(func() {
+		file_dberrors_proto_rawDescData = protoimpl.X.CompressGZIP(file_dberrors_proto_rawDescData)
+	})
+	return file_dberrors_proto_rawDescData
+}
+
+var file_dberrors_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_dberrors_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_dberrors_proto_goTypes = []interface{}{
+	(Kind)(0),             // 0: dberrors.v1.Kind
+	(Dialect)(0),          // 1: dberrors.v1.Dialect
+	(Source)(0),           // 2: dberrors.v1.Source
+	(DataErrorReason)(0),  // 3: dberrors.v1.DataErrorReason
+	(*Diagnostics)(nil),   // 4: dberrors.v1.Diagnostics
+	(*DatabaseError)(nil), // 5: dberrors.v1.DatabaseError
+}
+var file_dberrors_proto_depIdxs = []int32{
+	0, // 0: dberrors.v1.DatabaseError.kind:type_name -> dberrors.v1.Kind
+	1, // 1: dberrors.v1.DatabaseError.dialect:type_name -> dberrors.v1.Dialect
+	4, // 2: dberrors.v1.DatabaseError.diagnostics:type_name -> dberrors.v1.Diagnostics
+	3, // 3: dberrors.v1.DatabaseError.reason:type_name -> dberrors.v1.DataErrorReason
+	2, // 4: dberrors.v1.DatabaseError.source:type_name -> dberrors.v1.Source
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_dberrors_proto_init() }
+func file_dberrors_proto_init() {
+	if File_dberrors_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dberrors_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Diagnostics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dberrors_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dberrors_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_dberrors_proto_goTypes,
+		DependencyIndexes: file_dberrors_proto_depIdxs,
+		EnumInfos:         file_dberrors_proto_enumTypes,
+		MessageInfos:      file_dberrors_proto_msgTypes,
+	}.Build()
+	File_dberrors_proto = out.File
+	file_dberrors_proto_rawDesc = nil
+	file_dberrors_proto_goTypes = nil
+	file_dberrors_proto_depIdxs = nil
+}
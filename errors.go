@@ -13,43 +13,144 @@
 package dberrors
 
 import (
+	"errors"
 	"fmt"
 	"github.com/stackworx-go/dberrors/internal/dialect"
 )
 
+// Dialect Dialect export
+type Dialect = dialect.Dialect
+
+const (
+	// MYSQL MYSQL
+	MYSQL Dialect = dialect.MYSQL
+	// POSTGRES POSTGRES
+	POSTGRES Dialect = dialect.POSTGRES
+	// SQLITE3 SQLITE3
+	SQLITE3 Dialect = dialect.SQLITE3
+	// MSSQL MSSQL
+	MSSQL Dialect = dialect.MSSQL
+)
+
+// Diagnostics Diagnostics export
+// Additional diagnostic information reported by the database.
+// Fields which are not supported by a dialect are left empty.
+type Diagnostics struct {
+	// Native error code: SQLSTATE (postgres), error number (mysql and mssql) or extended result code (sqlite)
+	Code string `json:"-"`
+	// Severity of the error (postgres: ERROR, FATAL, PANIC)
+	Severity string `json:"severity,omitempty"`
+	// Detail of the error, values are redacted unless shown, see ShowValues (postgres)
+	Detail string `json:"detail,omitempty"`
+	// Hint suggesting what to do about the problem (postgres)
+	Hint string `json:"hint,omitempty"`
+	// Position of the error in the query, 1-based character offset (postgres)
+	Position int `json:"position,omitempty"`
+	// Position of the error in the internally generated query (postgres)
+	InternalPosition int `json:"internalPosition,omitempty"`
+	// Internally generated query which failed, e.g. a PL/pgSQL statement (postgres)
+	InternalQuery string `json:"internalQuery,omitempty"`
+	// Call stack context in which the error occurred, e.g. function or trigger (postgres)
+	Where string `json:"where,omitempty"`
+	// Source file of the database server which reported the error (postgres)
+	File string `json:"file,omitempty"`
+	// Source line of the database server which reported the error (postgres)
+	Line int `json:"line,omitempty"`
+	// Source routine of the database server which reported the error (postgres)
+	Routine string `json:"routine,omitempty"`
+	// Stored procedure or trigger in which the error occurred (mssql)
+	Procedure string `json:"procedure,omitempty"`
+	// Line in the batch or stored procedure at which the error occurred (mssql)
+	ProcedureLine int `json:"procedureLine,omitempty"`
+	// Name of the server which reported the error (mssql)
+	Server string `json:"server,omitempty"`
+	// Error state (mssql)
+	State uint8 `json:"state,omitempty"`
+	// Error class, the severity level of the error (mssql)
+	Class uint8 `json:"class,omitempty"`
+}
+
 // DbError DbError export
 type DbError struct {
-	err     error
-	dialect dialect.Dialect
+	err         error
+	dialect     dialect.Dialect
+	diagnostics Diagnostics
 }
 
 func NewDbError(err error, dialect dialect.Dialect) DbError {
 	return DbError{err: err, dialect: dialect}
 }
 
+func NewDbErrorWithDiagnostics(err error, dialect dialect.Dialect, diagnostics Diagnostics) DbError {
+	return DbError{err: err, dialect: dialect, diagnostics: diagnostics}
+}
+
 // Unwrap Unwrap implementation
+// Values in the message of the native error are redacted, see ShowValues.
 func (e *DbError) Unwrap() error {
-	return e.err
+	return e.native()
 }
 
+// Diagnostics Diagnostics implementation
+func (e *DbError) Diagnostics() Diagnostics {
+	diagnostics := e.diagnostics
+	if !valuesShown() {
+		diagnostics.Detail = Redact(diagnostics.Detail)
+	}
+
+	return diagnostics
+}
+
+// Dialect Dialect implementation
+func (e *DbError) Dialect() Dialect {
+	return e.dialect
+}
+
+// DataErrorReason DataErrorReason export
+type DataErrorReason string
+
+const (
+	// StringTruncation StringTruncation
+	StringTruncation DataErrorReason = "STRING_TRUNCATION"
+	// NumericOutOfRange NumericOutOfRange
+	NumericOutOfRange DataErrorReason = "NUMERIC_OUT_OF_RANGE"
+	// InvalidDatetime InvalidDatetime
+	InvalidDatetime DataErrorReason = "INVALID_DATETIME"
+	// InvalidTextRepresentation InvalidTextRepresentation
+	InvalidTextRepresentation DataErrorReason = "INVALID_TEXT_REPRESENTATION"
+	// DivisionByZero DivisionByZero
+	DivisionByZero DataErrorReason = "DIVISION_BY_ZERO"
+	// InvalidEnumValue InvalidEnumValue
+	InvalidEnumValue DataErrorReason = "INVALID_ENUM_VALUE"
+	// InvalidJSON InvalidJSON
+	InvalidJSON DataErrorReason = "INVALID_JSON"
+)
+
 // DataError DataError export
+// Reason is empty when the dialect does not report a more specific cause.
+// Row is 1-based and 0 when unknown.
 type DataError struct {
+	Reason DataErrorReason `json:"reason,omitempty"`
+	Table  string          `json:"table,omitempty"`
+	Column string          `json:"column,omitempty"`
+	Schema string          `json:"schema,omitempty"`
+	Row    int             `json:"row,omitempty"`
 	DbError
 }
 
 func (e *DataError) Error() string {
-	return fmt.Sprintf("data error %v", e.DbError.err)
+	return fmt.Sprint(e)
 }
 
 // CheckViolationError CheckViolationError export
 type CheckViolationError struct {
-	Table      string
-	Constraint string
+	Table      string `json:"table,omitempty"`
+	Constraint string `json:"constraint,omitempty"`
 	DbError
 }
 
 func (e *CheckViolationError) Error() string {
-	return fmt.Sprintf("check violation error %s.%s", e.Table, e.Constraint)
+	return fmt.Sprint(e)
 }
 
 // No inheritance
@@ -67,37 +168,267 @@ func (e *CheckViolationError) Error() string {
 
 // ForeignKeyViolationError ForeignKeyViolationError export
 type ForeignKeyViolationError struct {
-	Table      string
-	Constraint string
-	Schema     string
+	Table      string `json:"table,omitempty"`
+	Constraint string `json:"constraint,omitempty"`
+	Schema     string `json:"schema,omitempty"`
 	DbError
 }
 
 func (e *ForeignKeyViolationError) Error() string {
-	return fmt.Sprintf("not null violation error %s.%s", e.Table, e.Table)
+	return fmt.Sprint(e)
 }
 
 // NotNullViolationError NotNullViolationError export
 type NotNullViolationError struct {
-	Table  string
-	Column string
-	Schema string
+	Table  string `json:"table,omitempty"`
+	Column string `json:"column,omitempty"`
+	Schema string `json:"schema,omitempty"`
 	DbError
 }
 
 func (e *NotNullViolationError) Error() string {
-	return fmt.Sprintf("not null violation error %s.%s", e.Table, e.Table)
+	return fmt.Sprint(e)
 }
 
 // UniqueViolationError UniqueViolationError export
 type UniqueViolationError struct {
-	Table      string
-	Column     string
-	Constraint string
-	Schema     string
+	Table      string `json:"table,omitempty"`
+	Column     string `json:"column,omitempty"`
+	Constraint string `json:"constraint,omitempty"`
+	Schema     string `json:"schema,omitempty"`
 	DbError
 }
 
 func (e *UniqueViolationError) Error() string {
-	return fmt.Sprintf("unique violation error %s.%s", e.Table, e.Table)
+	return fmt.Sprint(e)
+}
+
+// Matches Matches implementation
+// Reports whether the violated constraint is named constraint, ignoring case, quoting and a table prefix.
+// SQLite does not report constraint names, for it the constraint is matched against "table.column".
+func (e *UniqueViolationError) Matches(constraint string) bool {
+	if e.Constraint != "" {
+		return constraintMatches(constraint, e.Constraint)
+	}
+
+	return e.Table != "" && constraintMatches(constraint, e.Table+"."+e.Column)
+}
+
+// Retryable Retryable export
+// Implemented by errors which may succeed when the transaction is retried.
+type Retryable interface {
+	IsRetryable() bool
+}
+
+// IsRetryable IsRetryable export
+// Reports whether any error in err's chain is retryable.
+func IsRetryable(err error) bool {
+	var retryable Retryable
+	if errors.As(err, &retryable) {
+		return retryable.IsRetryable()
+	}
+
+	return false
+}
+
+// DeadlockError DeadlockError export
+type DeadlockError struct {
+	DbError
+}
+
+func (e *DeadlockError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// IsRetryable IsRetryable implementation
+// The transaction was chosen as the deadlock victim and rolled back.
+func (e *DeadlockError) IsRetryable() bool {
+	return true
+}
+
+// SerializationFailureError SerializationFailureError export
+type SerializationFailureError struct {
+	DbError
+}
+
+func (e *SerializationFailureError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// IsRetryable IsRetryable implementation
+func (e *SerializationFailureError) IsRetryable() bool {
+	return true
+}
+
+// LockTimeoutError LockTimeoutError export
+type LockTimeoutError struct {
+	DbError
+}
+
+func (e *LockTimeoutError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// IsRetryable IsRetryable implementation
+// The lock may have been released since the statement gave up waiting.
+func (e *LockTimeoutError) IsRetryable() bool {
+	return true
+}
+
+// ConnectionError ConnectionError export
+type ConnectionError struct {
+	// MaybeExecuted reports whether the statement may have executed before the connection was lost.
+	// When true the outcome of a write, including a commit, is unknown.
+	MaybeExecuted bool `json:"maybeExecuted,omitempty"`
+	DbError
+}
+
+func (e *ConnectionError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// IsRetryable IsRetryable implementation
+// Only statements which did not reach the database are safe to retry.
+func (e *ConnectionError) IsRetryable() bool {
+	return !e.MaybeExecuted
+}
+
+// Source Source export
+type Source string
+
+const (
+	// ServerSource ServerSource
+	ServerSource Source = "SERVER"
+	// ClientSource ClientSource
+	ClientSource Source = "CLIENT"
+)
+
+// TimeoutError TimeoutError export
+// Source is ServerSource for server side limits such as statement_timeout and
+// ClientSource when the deadline of the client context expired.
+type TimeoutError struct {
+	Source Source `json:"source,omitempty"`
+	DbError
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// CanceledError CanceledError export
+// Source is ServerSource when the statement was killed on the server and
+// ClientSource when the client context was canceled.
+// Source is empty when it is not known, e.g. postgres reports canceled contexts and pg_cancel_backend alike.
+type CanceledError struct {
+	Source Source `json:"source,omitempty"`
+	DbError
+}
+
+func (e *CanceledError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// PermissionDeniedError PermissionDeniedError export
+type PermissionDeniedError struct {
+	// Database user which was denied, if reported
+	User string `json:"user,omitempty"`
+	// Privilege which was denied, e.g. SELECT or INSERT
+	Privilege string `json:"privilege,omitempty"`
+	// Type of the object, e.g. table, column, schema or database
+	ObjectType string `json:"objectType,omitempty"`
+	Object     string `json:"object,omitempty"`
+	Schema     string `json:"schema,omitempty"`
+	DbError
+}
+
+func (e *PermissionDeniedError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// AuthenticationError AuthenticationError export
+type AuthenticationError struct {
+	User string `json:"user,omitempty"`
+	DbError
+}
+
+func (e *AuthenticationError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// UndefinedObjectError UndefinedObjectError export
+type UndefinedObjectError struct {
+	// Type of the missing object, e.g. table, column, function, schema or database
+	ObjectType string `json:"objectType,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Schema     string `json:"schema,omitempty"`
+	DbError
+}
+
+func (e *UndefinedObjectError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// SyntaxError SyntaxError export
+type SyntaxError struct {
+	// Token at which the error was detected, empty at the end of the query
+	Token string `json:"token,omitempty"`
+	// Line of the query at which the error was detected, 1-based and 0 when unknown.
+	// Postgres only reports the Position, the query text is needed to derive the line, see Caret.
+	Line int `json:"line,omitempty"`
+	// Character offset in the query at which the error was detected, 1-based and 0 when unknown
+	Position int `json:"position,omitempty"`
+	DbError
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// ResourceExhaustedError ResourceExhaustedError export
+type ResourceExhaustedError struct {
+	// Exhausted resource: disk, memory or connections, empty when not reported
+	Resource string `json:"resource,omitempty"`
+	DbError
+}
+
+func (e *ResourceExhaustedError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// ReadOnlyError ReadOnlyError export
+// Raised when writing to a read-only database, server or transaction, e.g. a replica after a failover.
+type ReadOnlyError struct {
+	DbError
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// AbortedTransactionError AbortedTransactionError export
+// Raised for statements in a transaction which can no longer continue after an earlier error.
+// The earlier error is the actual cause, see WithSavepoint to recover from expected errors.
+type AbortedTransactionError struct {
+	DbError
+}
+
+func (e *AbortedTransactionError) Error() string {
+	return fmt.Sprint(e)
+}
+
+// ApplicationError ApplicationError export
+// Raised by application code running in the database, e.g. RAISE EXCEPTION in a postgres function,
+// SIGNAL in a mysql procedure, THROW in mssql or RAISE in a sqlite trigger.
+// See ApplicationErrorRegistry to map them to application specific errors.
+// The raised Message may contain values, it is left out of Error(), JSON, protobuf and the unwrapped
+// native error unless values are shown, see ShowValues.
+type ApplicationError struct {
+	// SQLSTATE (postgres) or error number (mysql, mssql and sqlite)
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	DbError
+}
+
+func (e *ApplicationError) Error() string {
+	return fmt.Sprint(e)
 }
@@ -0,0 +1,162 @@
+package dberrors
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+const (
+	// FieldRequired FieldRequired
+	FieldRequired = "required"
+	// FieldUnique FieldUnique
+	FieldUnique = "unique"
+	// FieldInvalid FieldInvalid
+	FieldInvalid = "invalid"
+)
+
+// FieldErrors FieldErrors export
+// Maps the columns of a constraint violation or data error onto the fields of model,
+// keyed by their json name:
+//
+//	NotNullViolationError{Column: "email"} => {"email": ["required"]}
+//
+// Columns are matched with the db tag of a field (the sqlx convention) or its lower cased name.
+// When model has a TableName() string method the error must be reported for that table.
+// err is classified with Parse, nil is returned when no column could be mapped.
+func FieldErrors(err error, model interface{}) map[string][]string {
+	table, columns, code := fieldErrorColumns(Parse(err))
+	if len(columns) == 0 {
+		return nil
+	}
+
+	if tabler, ok := model.(interface{ TableName() string }); ok && table != "" {
+		if !strings.EqualFold(tabler.TableName(), table) {
+			return nil
+		}
+	}
+
+	fields := make(map[string]string)
+	collectFields(reflect.TypeOf(model), fields)
+
+	var fieldErrors map[string][]string
+	for _, column := range columns {
+		if field, ok := fields[strings.ToLower(column)]; ok {
+			if fieldErrors == nil {
+				fieldErrors = make(map[string][]string)
+			}
+			fieldErrors[field] = append(fieldErrors[field], code)
+		}
+	}
+
+	return fieldErrors
+}
+
+// ColumnErrors ColumnErrors export
+// Reports the columns of a constraint violation or data error by column name, matching every column of the violation:
+//
+//	UniqueViolationError{Column: "a, b"} => {"a": ["unique"], "b": ["unique"]}
+//
+// Column names expose the database schema, FieldErrors maps them onto the fields of a model instead.
+// err is classified with Parse, nil is returned when the error has no column.
+func ColumnErrors(err error) map[string][]string {
+	_, columns, code := fieldErrorColumns(Parse(err))
+
+	var columnErrors map[string][]string
+	for _, column := range columns {
+		if columnErrors == nil {
+			columnErrors = make(map[string][]string)
+		}
+		columnErrors[column] = append(columnErrors[column], code)
+	}
+
+	return columnErrors
+}
+
+func fieldErrorColumns(err error) (string, []string, string) {
+	var notNullViolationError *NotNullViolationError
+	if errors.As(err, &notNullViolationError) && notNullViolationError.Column != "" {
+		return notNullViolationError.Table, []string{notNullViolationError.Column}, FieldRequired
+	}
+
+	var uniqueViolationError *UniqueViolationError
+	if errors.As(err, &uniqueViolationError) && uniqueViolationError.Column != "" {
+		// Postgres reports all columns of a composite key, e.g. "a, b"
+		var columns []string
+		for _, column := range strings.Split(uniqueViolationError.Column, ",") {
+			columns = append(columns, strings.Trim(strings.TrimSpace(column), `"`))
+		}
+
+		return uniqueViolationError.Table, columns, FieldUnique
+	}
+
+	var dataError *DataError
+	if errors.As(err, &dataError) && dataError.Column != "" {
+		return dataError.Table, []string{dataError.Column}, FieldInvalid
+	}
+
+	return "", nil, ""
+}
+
+// collectFields maps lower cased column names to json field names
+func collectFields(t reflect.Type, fields map[string]string) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return
+	}
+
+	var embedded []reflect.Type
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		column := tagName(field.Tag.Get("db"))
+		if column == "-" {
+			continue
+		}
+
+		if field.Anonymous && column == "" {
+			embedded = append(embedded, field.Type)
+			continue
+		}
+
+		// Unexported
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := tagName(field.Tag.Get("json"))
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if column == "" {
+			column = field.Name
+		}
+
+		column = strings.ToLower(column)
+		if _, ok := fields[column]; !ok {
+			fields[column] = name
+		}
+	}
+
+	// Fields of embedded structs are shadowed by the fields of the outer struct
+	for _, e := range embedded {
+		collectFields(e, fields)
+	}
+}
+
+func tagName(tag string) string {
+	if i := strings.Index(tag, ","); i >= 0 {
+		return tag[:i]
+	}
+
+	return tag
+}
@@ -0,0 +1,102 @@
+package dberrors_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stackworx-go/dberrors"
+	"github.com/stretchr/testify/assert"
+)
+
+type timestamps struct {
+	CreatedAt string `db:"created_at" json:"createdAt"`
+}
+
+type user struct {
+	timestamps
+	ID        int    `db:"id" json:"id"`
+	Email     string `db:"email" json:"email,omitempty"`
+	FirstName string `db:"first_name" json:"firstName"`
+	LastName  string `json:"lastName"`
+	Password  string `db:"password_hash" json:"-"`
+}
+
+func (user) TableName() string {
+	return "users"
+}
+
+func TestFieldErrors(t *testing.T) {
+	native := errors.New("native")
+
+	for _, tc := range []struct {
+		name     string
+		err      error
+		expected map[string][]string
+	}{{
+		name: "not null",
+		err: &dberrors.NotNullViolationError{
+			Table:   "users",
+			Column:  "email",
+			DbError: dberrors.NewDbError(native, dberrors.POSTGRES),
+		},
+		expected: map[string][]string{"email": {"required"}},
+	}, {
+		name: "unique composite key",
+		err: &dberrors.UniqueViolationError{
+			Table:   "users",
+			Column:  `first_name, "lastname"`,
+			DbError: dberrors.NewDbError(native, dberrors.POSTGRES),
+		},
+		expected: map[string][]string{"firstName": {"unique"}, "lastName": {"unique"}},
+	}, {
+		name: "data error without table",
+		err: &dberrors.DataError{
+			Column:  "created_at",
+			DbError: dberrors.NewDbError(native, dberrors.MYSQL),
+		},
+		expected: map[string][]string{"createdAt": {"invalid"}},
+	}, {
+		name: "other table",
+		err: &dberrors.NotNullViolationError{
+			Table:   "accounts",
+			Column:  "email",
+			DbError: dberrors.NewDbError(native, dberrors.POSTGRES),
+		},
+	}, {
+		name: "hidden field",
+		err: &dberrors.NotNullViolationError{
+			Table:   "users",
+			Column:  "password_hash",
+			DbError: dberrors.NewDbError(native, dberrors.POSTGRES),
+		},
+	}, {
+		name: "no column",
+		err: &dberrors.UniqueViolationError{
+			Constraint: "users_email_unique",
+			DbError:    dberrors.NewDbError(native, dberrors.MYSQL),
+		},
+	}, {
+		name: "other error",
+		err:  native,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, dberrors.FieldErrors(tc.err, &user{}))
+		})
+	}
+}
+
+func TestColumnErrors(t *testing.T) {
+	native := errors.New("native")
+
+	assert.Equal(t, map[string][]string{"first_name": {"unique"}, "last_name": {"unique"}}, dberrors.ColumnErrors(&dberrors.UniqueViolationError{
+		Table:   "users",
+		Column:  `first_name, "last_name"`,
+		DbError: dberrors.NewDbError(native, dberrors.POSTGRES),
+	}))
+	assert.Equal(t, map[string][]string{"email": {"required"}}, dberrors.ColumnErrors(&dberrors.NotNullViolationError{
+		Column:  "email",
+		DbError: dberrors.NewDbError(native, dberrors.MYSQL),
+	}))
+	assert.Nil(t, dberrors.ColumnErrors(&dberrors.DeadlockError{DbError: dberrors.NewDbError(native, dberrors.POSTGRES)}))
+	assert.Nil(t, dberrors.ColumnErrors(native))
+}
@@ -0,0 +1,346 @@
+package dberrors
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// attr is a key value pair printed by %+v, empty values are omitted
+type attr struct {
+	key   string
+	value interface{}
+}
+
+// describer is implemented by the standardized errors
+type describer interface {
+	standardized
+	Kind() Kind
+	// Unwrap returns the native error, redacted unless values are shown
+	Unwrap() error
+	// describe returns the violated object, printed by %v, and the fields of the error
+	describe() (string, []attr)
+}
+
+// formatError implements fmt.Formatter for the standardized errors.
+//
+// %v and %s print a concise message: the kind followed by the violated object, or the native message when there is none.
+//
+//	unique violation error users.users_email_key
+//	deadlock error pq: deadlock detected
+//
+// %+v adds the dialect, native code and the fields of the error followed by the native message.
+// Values in the native message are redacted, see ShowValues.
+//
+//	unique violation error users.users_email_key dialect=POSTGRES code=23505 schema=public table=users constraint=users_email_key: pq: duplicate key value violates unique constraint "users_email_key"
+func formatError(s fmt.State, verb rune, e describer) {
+	target, attrs := e.describe()
+	dbError := e.dbError()
+
+	var b strings.Builder
+
+	b.WriteString(phrase(e.Kind()))
+
+	if target != "" {
+		b.WriteString(" ")
+		b.WriteString(target)
+	}
+
+	native := e.Unwrap()
+
+	if verb == 'v' && s.Flag('+') {
+		attrs = append([]attr{
+			{"dialect", string(dbError.dialect)},
+			{"code", dbError.diagnostics.Code},
+		}, attrs...)
+
+		for _, a := range attrs {
+			if reflect.ValueOf(a.value).IsZero() {
+				continue
+			}
+
+			value := fmt.Sprint(a.value)
+
+			if strings.ContainsAny(value, " =\"") {
+				value = strconv.Quote(value)
+			}
+
+			b.WriteString(" ")
+			b.WriteString(a.key)
+			b.WriteString("=")
+			b.WriteString(value)
+		}
+
+		if native != nil {
+			b.WriteString(": ")
+			b.WriteString(native.Error())
+		}
+	} else if target == "" && native != nil {
+		b.WriteString(" ")
+		b.WriteString(native.Error())
+	}
+
+	if verb == 'q' {
+		_, _ = io.WriteString(s, strconv.Quote(b.String()))
+		return
+	}
+
+	_, _ = io.WriteString(s, b.String())
+}
+
+// phrase returns the kind in words, e.g. UNIQUE_VIOLATION => unique violation error
+func phrase(kind Kind) string {
+	p := strings.ToLower(strings.Replace(string(kind), "_", " ", -1))
+	if !strings.HasSuffix(p, "error") {
+		p += " error"
+	}
+
+	return p
+}
+
+// qualify joins the non empty parts of an identifier, e.g. table and column
+func qualify(parts ...string) string {
+	var nonEmpty []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+
+	return strings.Join(nonEmpty, ".")
+}
+
+func (e *DataError) describe() (string, []attr) {
+	return "", []attr{
+		{"reason", e.Reason},
+		{"schema", e.Schema},
+		{"table", e.Table},
+		{"column", e.Column},
+		{"row", e.Row},
+	}
+}
+
+// Format Format implementation
+func (e *DataError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *CheckViolationError) describe() (string, []attr) {
+	return qualify(e.Table, e.Constraint), []attr{
+		{"table", e.Table},
+		{"constraint", e.Constraint},
+	}
+}
+
+// Format Format implementation
+func (e *CheckViolationError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *ForeignKeyViolationError) describe() (string, []attr) {
+	return qualify(e.Table, e.Constraint), []attr{
+		{"schema", e.Schema},
+		{"table", e.Table},
+		{"constraint", e.Constraint},
+	}
+}
+
+// Format Format implementation
+func (e *ForeignKeyViolationError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *NotNullViolationError) describe() (string, []attr) {
+	return qualify(e.Table, e.Column), []attr{
+		{"schema", e.Schema},
+		{"table", e.Table},
+		{"column", e.Column},
+	}
+}
+
+// Format Format implementation
+func (e *NotNullViolationError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+// SQLite does not report constraint names, the table and columns are printed instead.
+func (e *UniqueViolationError) describe() (string, []attr) {
+	target := qualify(e.Table, e.Constraint)
+	if e.Constraint == "" {
+		target = qualify(e.Table, e.Column)
+	}
+
+	return target, []attr{
+		{"schema", e.Schema},
+		{"table", e.Table},
+		{"column", e.Column},
+		{"constraint", e.Constraint},
+	}
+}
+
+// Format Format implementation
+func (e *UniqueViolationError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *DeadlockError) describe() (string, []attr) {
+	return "", nil
+}
+
+// Format Format implementation
+func (e *DeadlockError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *SerializationFailureError) describe() (string, []attr) {
+	return "", nil
+}
+
+// Format Format implementation
+func (e *SerializationFailureError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *LockTimeoutError) describe() (string, []attr) {
+	return "", nil
+}
+
+// Format Format implementation
+func (e *LockTimeoutError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *ConnectionError) describe() (string, []attr) {
+	return "", []attr{
+		{"maybeExecuted", e.MaybeExecuted},
+	}
+}
+
+// Format Format implementation
+func (e *ConnectionError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *TimeoutError) describe() (string, []attr) {
+	return "", []attr{
+		{"source", e.Source},
+	}
+}
+
+// Format Format implementation
+func (e *TimeoutError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *CanceledError) describe() (string, []attr) {
+	return "", []attr{
+		{"source", e.Source},
+	}
+}
+
+// Format Format implementation
+func (e *CanceledError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *PermissionDeniedError) describe() (string, []attr) {
+	return strings.TrimSpace(e.ObjectType + " " + qualify(e.Schema, e.Object)), []attr{
+		{"user", e.User},
+		{"privilege", e.Privilege},
+		{"objectType", e.ObjectType},
+		{"schema", e.Schema},
+		{"object", e.Object},
+	}
+}
+
+// Format Format implementation
+func (e *PermissionDeniedError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *AuthenticationError) describe() (string, []attr) {
+	return e.User, []attr{
+		{"user", e.User},
+	}
+}
+
+// Format Format implementation
+func (e *AuthenticationError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *UndefinedObjectError) describe() (string, []attr) {
+	return strings.TrimSpace(e.ObjectType + " " + qualify(e.Schema, e.Name)), []attr{
+		{"objectType", e.ObjectType},
+		{"schema", e.Schema},
+		{"name", e.Name},
+	}
+}
+
+// Format Format implementation
+func (e *UndefinedObjectError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *SyntaxError) describe() (string, []attr) {
+	target := "at end of input"
+	if e.Token != "" {
+		target = "near " + strconv.Quote(e.Token)
+	}
+
+	return target, []attr{
+		{"token", e.Token},
+		{"line", e.Line},
+		{"position", e.Position},
+	}
+}
+
+// Format Format implementation
+func (e *SyntaxError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *ResourceExhaustedError) describe() (string, []attr) {
+	return e.Resource, []attr{
+		{"resource", e.Resource},
+	}
+}
+
+// Format Format implementation
+func (e *ResourceExhaustedError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *ReadOnlyError) describe() (string, []attr) {
+	return "", nil
+}
+
+// Format Format implementation
+func (e *ReadOnlyError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *AbortedTransactionError) describe() (string, []attr) {
+	return "", nil
+}
+
+// Format Format implementation
+func (e *AbortedTransactionError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
+func (e *ApplicationError) describe() (string, []attr) {
+	// The raised message may contain values
+	if !valuesShown() {
+		return e.Code, nil
+	}
+
+	return strings.TrimPrefix(strings.TrimSuffix(e.Code+": "+e.Message, ": "), ": "), nil
+}
+
+// Format Format implementation
+func (e *ApplicationError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
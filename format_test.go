@@ -0,0 +1,70 @@
+package dberrors_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stackworx-go/dberrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	dbError := dberrors.NewDbErrorWithDiagnostics(errors.New(`pq: duplicate key value violates unique constraint "users_email_key"`), dberrors.POSTGRES, dberrors.Diagnostics{Code: "23505"})
+	native := errors.New("native")
+
+	for _, tc := range []struct {
+		err     error
+		concise string
+		verbose string
+	}{{
+		err:     &dberrors.UniqueViolationError{Table: "users", Column: "email", Constraint: "users_email_key", Schema: "public", DbError: dbError},
+		concise: "unique violation error users.users_email_key",
+		verbose: `unique violation error users.users_email_key dialect=POSTGRES code=23505 schema=public table=users column=email constraint=users_email_key: pq: duplicate key value violates unique constraint "users_email_key"`,
+	}, {
+		err:     &dberrors.UniqueViolationError{Table: "users", Column: "email", DbError: dberrors.NewDbError(native, dberrors.SQLITE3)},
+		concise: "unique violation error users.email",
+		verbose: "unique violation error users.email dialect=SQLITE3 table=users column=email: native",
+	}, {
+		err:     &dberrors.ForeignKeyViolationError{Table: "orders", Constraint: "orders_user_id_fkey", DbError: dberrors.NewDbError(native, dberrors.MYSQL)},
+		concise: "foreign key violation error orders.orders_user_id_fkey",
+		verbose: "foreign key violation error orders.orders_user_id_fkey dialect=MYSQL table=orders constraint=orders_user_id_fkey: native",
+	}, {
+		err:     &dberrors.NotNullViolationError{Table: "users", Column: "email", DbError: dberrors.NewDbError(native, dberrors.MSSQL)},
+		concise: "not null violation error users.email",
+		verbose: "not null violation error users.email dialect=MSSQL table=users column=email: native",
+	}, {
+		err:     &dberrors.DataError{Reason: dberrors.NumericOutOfRange, Column: "age", Row: 2, DbError: dberrors.NewDbError(native, dberrors.MYSQL)},
+		concise: "data error native",
+		verbose: "data error dialect=MYSQL reason=NUMERIC_OUT_OF_RANGE column=age row=2: native",
+	}, {
+		err:     &dberrors.ConnectionError{MaybeExecuted: true, DbError: dberrors.NewDbError(native, dberrors.POSTGRES)},
+		concise: "connection error native",
+		verbose: "connection error dialect=POSTGRES maybeExecuted=true: native",
+	}, {
+		err:     &dberrors.SyntaxError{Token: "form", Line: 1, Position: 10, DbError: dberrors.NewDbError(native, dberrors.POSTGRES)},
+		concise: `syntax error near "form"`,
+		verbose: `syntax error near "form" dialect=POSTGRES token=form line=1 position=10: native`,
+	}, {
+		err:     &dberrors.ApplicationError{Code: "P0001", Message: "insufficient funds", DbError: dberrors.NewDbError(native, dberrors.POSTGRES)},
+		concise: "application error P0001",
+		verbose: "application error P0001 dialect=POSTGRES: native",
+	}} {
+		t.Run(tc.concise, func(t *testing.T) {
+			assert.EqualError(t, tc.err, tc.concise)
+			assert.Equal(t, tc.concise, fmt.Sprintf("%v", tc.err))
+			assert.Equal(t, tc.concise, fmt.Sprintf("%s", tc.err))
+			assert.Equal(t, fmt.Sprintf("%q", tc.concise), fmt.Sprintf("%q", tc.err))
+			assert.Equal(t, tc.verbose, fmt.Sprintf("%+v", tc.err))
+		})
+	}
+}
+
+func TestFormatRedacted(t *testing.T) {
+	err := &dberrors.UniqueViolationError{
+		Constraint: "users.email",
+		DbError:    dberrors.NewDbError(errors.New("Error 1062: Duplicate entry 'jane@example.com' for key 'users.email'"), dberrors.MYSQL),
+	}
+
+	assert.Equal(t, "unique violation error users.email dialect=MYSQL constraint=users.email: Error 1062: Duplicate entry '?' for key 'users.email'", fmt.Sprintf("%+v", err))
+}
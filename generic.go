@@ -0,0 +1,71 @@
+package dberrors
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+)
+
+// parseGeneric classifies errors which are not specific to a dialect, such as errors raised by database/sql,
+// the context or the network. d is the dialect of the connection, empty when it is not known.
+func parseGeneric(err error, d Dialect) error {
+	if err := contextError(err, d); err != nil {
+		return err
+	}
+
+	return connectionError(err, d)
+}
+
+func contextError(err error, d Dialect) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &TimeoutError{
+			Source:  ClientSource,
+			DbError: NewDbError(err, d),
+		}
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return &CanceledError{
+			Source:  ClientSource,
+			DbError: NewDbError(err, d),
+		}
+	}
+
+	return nil
+}
+
+func connectionError(err error, d Dialect) error {
+	// context errors satisfy net.Error
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return nil
+	}
+
+	// Drivers should only return ErrBadConn when the statement was not sent, but pq also
+	// returns it when the connection is lost afterwards, e.g. while waiting for the result of a commit
+	if errors.Is(err, driver.ErrBadConn) {
+		return &ConnectionError{
+			MaybeExecuted: true,
+			DbError:       NewDbError(err, d),
+		}
+	}
+
+	var opError *net.OpError
+	if errors.As(err, &opError) && opError.Op == "dial" {
+		return &ConnectionError{
+			MaybeExecuted: false,
+			DbError:       NewDbError(err, d),
+		}
+	}
+
+	var netError net.Error
+	if errors.As(err, &netError) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return &ConnectionError{
+			MaybeExecuted: true,
+			DbError:       NewDbError(err, d),
+		}
+	}
+
+	return nil
+}
@@ -5,7 +5,9 @@ go 1.13
 require (
 	github.com/denisenkom/go-mssqldb v0.0.0-20200206145737-bbfc9a55622e
 	github.com/go-sql-driver/mysql v1.5.0
+	github.com/golang/protobuf v1.4.2
 	github.com/lib/pq v1.3.0
-	github.com/mattn/go-sqlite3 v2.0.3+incompatible
-	github.com/stretchr/testify v1.5.1
+	github.com/mattn/go-sqlite3 v1.14.12
+	github.com/stretchr/testify v1.7.0
+	google.golang.org/protobuf v1.25.0
 )
@@ -0,0 +1,13 @@
+module github.com/stackworx-go/dberrors/gqlerr
+
+go 1.13
+
+require (
+	github.com/99designs/gqlgen v0.13.0
+	github.com/stackworx-go/dberrors v0.0.0
+	github.com/stretchr/testify v1.7.0
+	github.com/vektah/gqlparser/v2 v2.1.0
+)
+
+// Builds against the core package of this repository, bump the requirement to a tagged release when releasing
+replace github.com/stackworx-go/dberrors => ../
@@ -0,0 +1,150 @@
+// Package gqlerr presents standardized database errors as GraphQL errors for gqlgen servers.
+// Database messages are never exposed, errors are reported with a safe message and
+// extensions.code set to the dberrors.Kind of the error.
+package gqlerr
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stackworx-go/dberrors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// DefaultMessages DefaultMessages export
+// Safe messages reported for each kind, see Options.Messages to override them
+var DefaultMessages = map[dberrors.Kind]string{
+	dberrors.KindUniqueViolation:      "already exists",
+	dberrors.KindForeignKeyViolation:  "references a missing or in use record",
+	dberrors.KindNotNullViolation:     "is required",
+	dberrors.KindCheckViolation:       "is invalid",
+	dberrors.KindDataError:            "is invalid",
+	dberrors.KindApplication:          "could not be processed",
+	dberrors.KindDeadlock:             "conflicted with a concurrent request, please retry",
+	dberrors.KindSerializationFailure: "conflicted with a concurrent request, please retry",
+	dberrors.KindLockTimeout:          "conflicted with a concurrent request, please retry",
+	dberrors.KindConnection:           "service unavailable",
+	dberrors.KindResourceExhausted:    "service unavailable",
+	dberrors.KindReadOnly:             "service unavailable",
+	dberrors.KindTimeout:              "request timed out",
+	dberrors.KindCanceled:             "request canceled",
+	dberrors.KindPermissionDenied:     "permission denied",
+	dberrors.KindAuthentication:       "internal system error",
+	dberrors.KindUndefinedObject:      "internal system error",
+	dberrors.KindSyntax:               "internal system error",
+	dberrors.KindAbortedTransaction:   "internal system error",
+}
+
+// Options Options export
+type Options struct {
+	// Used to map columns to GraphQL field names for extensions.field, see dberrors.FieldErrors.
+	// Without a model the field is only reported for rules registered with a dberrors.Registry.
+	Model interface{}
+	// Overrides DefaultMessages
+	Messages map[dberrors.Kind]string
+	// Presents errors which are not database errors, defaults to graphql.DefaultErrorPresenter
+	Fallback graphql.ErrorPresenterFunc
+	// Recovers panics which are not database errors, defaults to graphql.DefaultRecover
+	Recover graphql.RecoverFunc
+}
+
+// Present Present export
+// Builds the GraphQL error for err, which is classified with dberrors.Parse.
+// Returns nil when err is not a database error.
+func Present(ctx context.Context, err error, opts *Options) *gqlerror.Error {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	parsedErr := dberrors.Parse(err)
+	kind := dberrors.KindOf(parsedErr)
+
+	message, ok := opts.Messages[kind]
+	if !ok {
+		message, ok = DefaultMessages[kind]
+	}
+	if !ok {
+		return nil
+	}
+
+	extensions := map[string]interface{}{
+		"code": string(kind),
+	}
+
+	// Rules registered with a dberrors.Registry take precedence
+	var constraintError *dberrors.ConstraintError
+	if errors.As(err, &constraintError) && constraintError.Field != "" {
+		extensions["field"] = constraintError.Field
+		if constraintError.MessageKey != "" {
+			extensions["messageKey"] = constraintError.MessageKey
+		}
+	} else if opts.Model != nil {
+		fieldErrors := dberrors.FieldErrors(parsedErr, opts.Model)
+
+		fields := make([]string, 0, len(fieldErrors))
+		for field := range fieldErrors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		switch len(fields) {
+		case 0:
+		case 1:
+			extensions["field"] = fields[0]
+		default:
+			// Composite keys
+			extensions["fields"] = fields
+		}
+	}
+
+	presented := &gqlerror.Error{
+		Message:    message,
+		Path:       graphql.GetPath(ctx),
+		Extensions: extensions,
+	}
+
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		if gqlErr.Path != nil {
+			presented.Path = gqlErr.Path
+		}
+		presented.Locations = gqlErr.Locations
+	}
+
+	return presented
+}
+
+// ErrorPresenter ErrorPresenter export
+// For use with handler.Server.SetErrorPresenter
+func ErrorPresenter(opts *Options) graphql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		if presented := Present(ctx, err, opts); presented != nil {
+			return presented
+		}
+
+		if opts != nil && opts.Fallback != nil {
+			return opts.Fallback(ctx, err)
+		}
+
+		return graphql.DefaultErrorPresenter(ctx, err)
+	}
+}
+
+// RecoverFunc RecoverFunc export
+// For use with handler.Server.SetRecoverFunc.
+// Database errors raised with panic are returned as is so that the error presenter reports them.
+func RecoverFunc(opts *Options) graphql.RecoverFunc {
+	return func(ctx context.Context, recovered interface{}) error {
+		if err, ok := recovered.(error); ok && dberrors.KindOf(dberrors.Parse(err)) != "" {
+			return err
+		}
+
+		if opts != nil && opts.Recover != nil {
+			return opts.Recover(ctx, recovered)
+		}
+
+		return graphql.DefaultRecover(ctx, recovered)
+	}
+}
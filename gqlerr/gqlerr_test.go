@@ -0,0 +1,114 @@
+package gqlerr_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/gqlerr"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type user struct {
+	FirstName string `db:"first_name" json:"firstName"`
+	LastName  string `db:"last_name" json:"lastName"`
+}
+
+func TestErrorPresenter(t *testing.T) {
+	native := errors.New(`duplicate key value violates unique constraint "users_name_key"`)
+	path := ast.Path{ast.PathName("createUser")}
+
+	for _, tc := range []struct {
+		name     string
+		err      error
+		opts     *gqlerr.Options
+		expected *gqlerror.Error
+	}{{
+		name: "unique violation",
+		err: gqlerror.WrapPath(path, &dberrors.UniqueViolationError{
+			Table:   "users",
+			Column:  "first_name, last_name",
+			DbError: dberrors.NewDbError(native, dberrors.POSTGRES),
+		}),
+		opts: &gqlerr.Options{Model: user{}},
+		expected: &gqlerror.Error{
+			Message:    "already exists",
+			Path:       path,
+			Extensions: map[string]interface{}{"code": "UNIQUE_VIOLATION", "fields": []string{"firstName", "lastName"}},
+		},
+	}, {
+		name: "not null violation",
+		err: gqlerror.WrapPath(path, &dberrors.NotNullViolationError{
+			Table:   "users",
+			Column:  "first_name",
+			DbError: dberrors.NewDbError(native, dberrors.MYSQL),
+		}),
+		opts: &gqlerr.Options{
+			Model:    user{},
+			Messages: map[dberrors.Kind]string{dberrors.KindNotNullViolation: "must not be blank"},
+		},
+		expected: &gqlerror.Error{
+			Message:    "must not be blank",
+			Path:       path,
+			Extensions: map[string]interface{}{"code": "NOT_NULL_VIOLATION", "field": "firstName"},
+		},
+	}, {
+		name: "without model",
+		err: gqlerror.WrapPath(path, &dberrors.DataError{
+			Column:  "first_name",
+			DbError: dberrors.NewDbError(native, dberrors.MSSQL),
+		}),
+		expected: &gqlerror.Error{
+			Message:    "is invalid",
+			Path:       path,
+			Extensions: map[string]interface{}{"code": "DATA_ERROR"},
+		},
+	}, {
+		name: "other error",
+		err:  gqlerror.WrapPath(path, errors.New("not found")),
+		expected: &gqlerror.Error{
+			Message: "not found",
+			Path:    path,
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			presented := gqlerr.ErrorPresenter(tc.opts)(context.Background(), tc.err)
+
+			assert.Equal(t, tc.expected.Message, presented.Message)
+			assert.Equal(t, tc.expected.Path, presented.Path)
+			assert.Equal(t, tc.expected.Extensions, presented.Extensions)
+		})
+	}
+}
+
+func TestErrorPresenterConstraintError(t *testing.T) {
+	registry := dberrors.NewRegistry()
+	registry.Register(dberrors.ConstraintRule{
+		Constraint: "users_name_key",
+		Field:      "name",
+		MessageKey: "user.name.taken",
+	})
+
+	err := registry.Resolve(&dberrors.UniqueViolationError{
+		Table:      "users",
+		Constraint: "users_name_key",
+		DbError:    dberrors.NewDbError(errors.New("native"), dberrors.POSTGRES),
+	})
+
+	presented := gqlerr.ErrorPresenter(nil)(context.Background(), err)
+	assert.Equal(t, map[string]interface{}{"code": "UNIQUE_VIOLATION", "field": "name", "messageKey": "user.name.taken"}, presented.Extensions)
+}
+
+func TestRecoverFunc(t *testing.T) {
+	dbErr := &dberrors.ConnectionError{DbError: dberrors.NewDbError(errors.New("native"), dberrors.POSTGRES)}
+
+	recover := gqlerr.RecoverFunc(&gqlerr.Options{Recover: func(ctx context.Context, recovered interface{}) error {
+		return errors.New("internal system error")
+	}})
+
+	assert.Equal(t, dbErr, recover(context.Background(), dbErr))
+	assert.EqualError(t, recover(context.Background(), "boom"), "internal system error")
+}
@@ -0,0 +1,14 @@
+module github.com/stackworx-go/dberrors/grpcerr
+
+go 1.13
+
+require (
+	github.com/golang/protobuf v1.4.2
+	github.com/stackworx-go/dberrors v0.0.0
+	github.com/stretchr/testify v1.7.0
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013
+	google.golang.org/grpc v1.38.0
+)
+
+// Builds against the core package of this repository, bump the requirement to a tagged release when releasing
+replace github.com/stackworx-go/dberrors => ../
@@ -0,0 +1,233 @@
+// Package grpcerr translates standardized database errors into gRPC statuses
+// with google.rpc.ErrorInfo and google.rpc.BadRequest details.
+package grpcerr
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stackworx-go/dberrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultDomain DefaultDomain export
+// Domain of the ErrorInfo detail, the reason is the dberrors.Kind of the error
+const DefaultDomain = "dberrors"
+
+// Options Options export
+type Options struct {
+	// Domain of the ErrorInfo detail, defaults to DefaultDomain
+	Domain string
+	// Include table, constraint and column names in the ErrorInfo metadata.
+	// These are hidden by default as they expose the database schema.
+	IncludeIdentifiers bool
+	// Used to map columns to field names for BadRequest field violations, see dberrors.FieldErrors.
+	// Columns are only reported when IncludeIdentifiers is true if no model is set.
+	Model interface{}
+	// Overrides the code of kinds, e.g. to respond to lock timeouts with Unavailable instead of Aborted
+	Codes map[dberrors.Kind]codes.Code
+	// Called after the status has been built to customize it
+	Customize func(err error, st *status.Status) *status.Status
+}
+
+var mappings = map[dberrors.Kind]codes.Code{
+	dberrors.KindUniqueViolation:      codes.AlreadyExists,
+	dberrors.KindForeignKeyViolation:  codes.FailedPrecondition,
+	dberrors.KindNotNullViolation:     codes.InvalidArgument,
+	dberrors.KindCheckViolation:       codes.InvalidArgument,
+	dberrors.KindDataError:            codes.InvalidArgument,
+	dberrors.KindApplication:          codes.FailedPrecondition,
+	dberrors.KindDeadlock:             codes.Aborted,
+	dberrors.KindSerializationFailure: codes.Aborted,
+	dberrors.KindLockTimeout:          codes.Aborted,
+	dberrors.KindConnection:           codes.Unavailable,
+	dberrors.KindReadOnly:             codes.Unavailable,
+	dberrors.KindResourceExhausted:    codes.ResourceExhausted,
+	dberrors.KindTimeout:              codes.DeadlineExceeded,
+	dberrors.KindCanceled:             codes.Canceled,
+	dberrors.KindPermissionDenied:     codes.PermissionDenied,
+	dberrors.KindAuthentication:       codes.Internal,
+	dberrors.KindUndefinedObject:      codes.Internal,
+	dberrors.KindSyntax:               codes.Internal,
+	dberrors.KindAbortedTransaction:   codes.Internal,
+}
+
+// Status Status export
+// Builds the status for err, which is classified with dberrors.Parse.
+// Returns false when err is not a database error.
+// The message is derived from the kind so that database messages, which may contain values, are not exposed.
+func Status(err error, opts *Options) (*status.Status, bool) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	parsedErr := dberrors.Parse(err)
+	kind := dberrors.KindOf(parsedErr)
+
+	code, ok := mappings[kind]
+	if !ok {
+		return nil, false
+	}
+
+	if c, ok := opts.Codes[kind]; ok {
+		code = c
+	}
+
+	domain := opts.Domain
+	if domain == "" {
+		domain = DefaultDomain
+	}
+
+	var details []proto.Message
+	details = append(details, &errdetails.ErrorInfo{
+		Reason:   string(kind),
+		Domain:   domain,
+		Metadata: metadata(parsedErr, opts),
+	})
+
+	if badRequest := badRequest(err, parsedErr, opts); badRequest != nil {
+		details = append(details, badRequest)
+	}
+
+	st := status.New(code, strings.Replace(strings.ToLower(string(kind)), "_", " ", -1))
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+
+	if opts.Customize != nil {
+		st = opts.Customize(err, st)
+	}
+
+	return st, true
+}
+
+// Error Error export
+// Returns the status error for database errors and err unchanged otherwise.
+func Error(err error, opts *Options) error {
+	if err == nil {
+		return nil
+	}
+
+	// Already converted, e.g. by the handler
+	if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return err
+	}
+
+	st, ok := Status(err, opts)
+	if !ok {
+		return err
+	}
+
+	return st.Err()
+}
+
+// UnaryServerInterceptor UnaryServerInterceptor export
+// Converts database errors returned by unary handlers
+func UnaryServerInterceptor(opts *Options) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, Error(err, opts)
+		}
+
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor StreamServerInterceptor export
+// Converts database errors returned by stream handlers
+func StreamServerInterceptor(opts *Options) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return Error(handler(srv, ss), opts)
+	}
+}
+
+func metadata(err error, opts *Options) map[string]string {
+	m := map[string]string{}
+
+	var table, column, constraint string
+
+	var uniqueViolationError *dberrors.UniqueViolationError
+	var foreignKeyViolationError *dberrors.ForeignKeyViolationError
+	var checkViolationError *dberrors.CheckViolationError
+	var notNullViolationError *dberrors.NotNullViolationError
+	var dataError *dberrors.DataError
+
+	switch {
+	case errors.As(err, &uniqueViolationError):
+		table, column, constraint = uniqueViolationError.Table, uniqueViolationError.Column, uniqueViolationError.Constraint
+	case errors.As(err, &foreignKeyViolationError):
+		table, constraint = foreignKeyViolationError.Table, foreignKeyViolationError.Constraint
+	case errors.As(err, &checkViolationError):
+		table, constraint = checkViolationError.Table, checkViolationError.Constraint
+	case errors.As(err, &notNullViolationError):
+		table, column = notNullViolationError.Table, notNullViolationError.Column
+	case errors.As(err, &dataError):
+		table, column = dataError.Table, dataError.Column
+		if dataError.Reason != "" {
+			m["reason"] = string(dataError.Reason)
+		}
+	}
+
+	if opts.IncludeIdentifiers {
+		for key, value := range map[string]string{"table": table, "column": column, "constraint": constraint} {
+			if value != "" {
+				m[key] = value
+			}
+		}
+	}
+
+	var dbError interface{ Dialect() dberrors.Dialect }
+	if errors.As(err, &dbError) {
+		m["dialect"] = string(dbError.Dialect())
+	}
+
+	return m
+}
+
+func badRequest(err, parsedErr error, opts *Options) *errdetails.BadRequest {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	// Rules registered with a dberrors.Registry take precedence
+	var constraintError *dberrors.ConstraintError
+	if errors.As(err, &constraintError) && constraintError.Field != "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       constraintError.Field,
+			Description: constraintError.MessageKey,
+		})
+	} else {
+		var fieldErrors map[string][]string
+		if opts.Model != nil {
+			fieldErrors = dberrors.FieldErrors(parsedErr, opts.Model)
+		} else if opts.IncludeIdentifiers {
+			fieldErrors = dberrors.ColumnErrors(parsedErr)
+		}
+
+		fields := make([]string, 0, len(fieldErrors))
+		for field := range fieldErrors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			for _, description := range fieldErrors[field] {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{
+					Field:       field,
+					Description: description,
+				})
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &errdetails.BadRequest{FieldViolations: violations}
+}
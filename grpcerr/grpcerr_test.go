@@ -0,0 +1,201 @@
+package grpcerr_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/grpcerr"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type user struct {
+	Email string `db:"email" json:"emailAddress"`
+}
+
+func TestStatus(t *testing.T) {
+	native := errors.New("native")
+
+	for _, tc := range []struct {
+		name    string
+		err     error
+		opts    *grpcerr.Options
+		code    codes.Code
+		message string
+		info    *errdetails.ErrorInfo
+		fields  []*errdetails.BadRequest_FieldViolation
+	}{{
+		name: "unique violation",
+		err: fmt.Errorf("create user: %w", &dberrors.UniqueViolationError{
+			Table:      "users",
+			Column:     "email",
+			Constraint: "users_email_key",
+			DbError:    dberrors.NewDbError(native, dberrors.POSTGRES),
+		}),
+		opts:    &grpcerr.Options{Model: user{}, IncludeIdentifiers: true},
+		code:    codes.AlreadyExists,
+		message: "unique violation",
+		info: &errdetails.ErrorInfo{
+			Reason:   "UNIQUE_VIOLATION",
+			Domain:   "dberrors",
+			Metadata: map[string]string{"table": "users", "column": "email", "constraint": "users_email_key", "dialect": "POSTGRES"},
+		},
+		fields: []*errdetails.BadRequest_FieldViolation{{Field: "emailAddress", Description: dberrors.FieldUnique}},
+	}, {
+		name: "foreign key violation",
+		err: &dberrors.ForeignKeyViolationError{
+			Table:      "orders",
+			Constraint: "orders_user_id_fkey",
+			DbError:    dberrors.NewDbError(native, dberrors.MYSQL),
+		},
+		opts:    &grpcerr.Options{Domain: "orders.example.com", IncludeIdentifiers: true},
+		code:    codes.FailedPrecondition,
+		message: "foreign key violation",
+		info: &errdetails.ErrorInfo{
+			Reason:   "FOREIGN_KEY_VIOLATION",
+			Domain:   "orders.example.com",
+			Metadata: map[string]string{"table": "orders", "constraint": "orders_user_id_fkey", "dialect": "MYSQL"},
+		},
+	}, {
+		name: "not null violation",
+		err: &dberrors.NotNullViolationError{
+			Table:   "users",
+			Column:  "email",
+			DbError: dberrors.NewDbError(native, dberrors.SQLITE3),
+		},
+		opts:    &grpcerr.Options{IncludeIdentifiers: true},
+		code:    codes.InvalidArgument,
+		message: "not null violation",
+		info: &errdetails.ErrorInfo{
+			Reason:   "NOT_NULL_VIOLATION",
+			Domain:   "dberrors",
+			Metadata: map[string]string{"table": "users", "column": "email", "dialect": "SQLITE3"},
+		},
+		fields: []*errdetails.BadRequest_FieldViolation{{Field: "email", Description: dberrors.FieldRequired}},
+	}, {
+		name: "identifiers hidden",
+		err: &dberrors.UniqueViolationError{
+			Table:      "users",
+			Column:     "email",
+			Constraint: "users_email_key",
+			DbError:    dberrors.NewDbError(native, dberrors.POSTGRES),
+		},
+		code:    codes.AlreadyExists,
+		message: "unique violation",
+		info: &errdetails.ErrorInfo{
+			Reason:   "UNIQUE_VIOLATION",
+			Domain:   "dberrors",
+			Metadata: map[string]string{"dialect": "POSTGRES"},
+		},
+	}, {
+		name:    "serialization failure",
+		err:     &dberrors.SerializationFailureError{DbError: dberrors.NewDbError(native, dberrors.POSTGRES)},
+		code:    codes.Aborted,
+		message: "serialization failure",
+		info: &errdetails.ErrorInfo{
+			Reason:   "SERIALIZATION_FAILURE",
+			Domain:   "dberrors",
+			Metadata: map[string]string{"dialect": "POSTGRES"},
+		},
+	}, {
+		name:    "code override",
+		err:     &dberrors.ConnectionError{DbError: dberrors.NewDbError(native, dberrors.MSSQL)},
+		opts:    &grpcerr.Options{Codes: map[dberrors.Kind]codes.Code{dberrors.KindConnection: codes.Internal}},
+		code:    codes.Internal,
+		message: "connection error",
+		info: &errdetails.ErrorInfo{
+			Reason:   "CONNECTION_ERROR",
+			Domain:   "dberrors",
+			Metadata: map[string]string{"dialect": "MSSQL"},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			st, ok := grpcerr.Status(tc.err, tc.opts)
+			if !assert.True(t, ok) {
+				return
+			}
+
+			assert.Equal(t, tc.code, st.Code())
+			assert.Equal(t, tc.message, st.Message())
+
+			var info *errdetails.ErrorInfo
+			var badRequest *errdetails.BadRequest
+			for _, detail := range st.Details() {
+				switch d := detail.(type) {
+				case *errdetails.ErrorInfo:
+					info = d
+				case *errdetails.BadRequest:
+					badRequest = d
+				}
+			}
+
+			assert.True(t, proto.Equal(tc.info, info), "ErrorInfo %v", info)
+
+			if tc.fields == nil {
+				assert.Nil(t, badRequest)
+			} else {
+				assert.True(t, proto.Equal(&errdetails.BadRequest{FieldViolations: tc.fields}, badRequest), "BadRequest %v", badRequest)
+			}
+		})
+	}
+}
+
+func TestStatusUnknown(t *testing.T) {
+	_, ok := grpcerr.Status(errors.New("boom"), nil)
+	assert.False(t, ok)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := grpcerr.UnaryServerInterceptor(nil)
+	info := &grpc.UnaryServerInfo{FullMethod: "/users.Users/Create"}
+
+	dbErr := &dberrors.UniqueViolationError{
+		Table:   "users",
+		Column:  "email",
+		DbError: dberrors.NewDbError(errors.New("native"), dberrors.SQLITE3),
+	}
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, dbErr
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	converted := status.Error(codes.NotFound, "not found")
+	_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, converted
+	})
+	assert.Equal(t, converted, err)
+
+	other := errors.New("boom")
+	_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, other
+	})
+	assert.Equal(t, other, err)
+
+	resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := grpcerr.StreamServerInterceptor(nil)
+	info := &grpc.StreamServerInfo{FullMethod: "/users.Users/Import"}
+
+	err := interceptor(nil, nil, info, func(srv interface{}, stream grpc.ServerStream) error {
+		return &dberrors.ConnectionError{DbError: dberrors.NewDbError(errors.New("native"), dberrors.POSTGRES)}
+	})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	assert.NoError(t, interceptor(nil, nil, info, func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	}))
+}
@@ -0,0 +1,240 @@
+// Package httperr translates standardized database errors into
+// RFC 7807 problem details (application/problem+json) responses.
+package httperr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/stackworx-go/dberrors"
+)
+
+// ContentType ContentType export
+const ContentType = "application/problem+json"
+
+// DefaultBaseURI DefaultBaseURI export
+// Problem types are the base URI followed by the kind, e.g. urn:dberrors:problem:unique-violation
+const DefaultBaseURI = "urn:dberrors:problem:"
+
+// InvalidParam InvalidParam export
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Problem Problem export
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+	// Only set when Options.IncludeIdentifiers is true
+	Table      string `json:"table,omitempty"`
+	Constraint string `json:"constraint,omitempty"`
+}
+
+// Options Options export
+type Options struct {
+	// Prefix of the problem type URIs, defaults to DefaultBaseURI
+	BaseURI string
+	// Include table, constraint and column names in the problem.
+	// These are hidden by default as they expose the database schema.
+	IncludeIdentifiers bool
+	// Used to map columns to json field names for invalid-params, see dberrors.FieldErrors.
+	// Columns are only reported when IncludeIdentifiers is true if no model is set.
+	Model interface{}
+	// Overrides the status of kinds, e.g. to respond to deadlocks with 503 instead of 409
+	Statuses map[dberrors.Kind]int
+	// Called after the problem has been built to customize it
+	Customize func(err error, problem *Problem)
+}
+
+type mapping struct {
+	status int
+	title  string
+}
+
+var mappings = map[dberrors.Kind]mapping{
+	dberrors.KindUniqueViolation:      {http.StatusConflict, "Unique violation"},
+	dberrors.KindForeignKeyViolation:  {http.StatusConflict, "Foreign key violation"},
+	dberrors.KindNotNullViolation:     {http.StatusUnprocessableEntity, "Not null violation"},
+	dberrors.KindCheckViolation:       {http.StatusUnprocessableEntity, "Check violation"},
+	dberrors.KindDataError:            {http.StatusUnprocessableEntity, "Invalid data"},
+	dberrors.KindApplication:          {http.StatusUnprocessableEntity, "Application error"},
+	dberrors.KindDeadlock:             {http.StatusConflict, "Deadlock"},
+	dberrors.KindSerializationFailure: {http.StatusConflict, "Serialization failure"},
+	dberrors.KindLockTimeout:          {http.StatusServiceUnavailable, "Lock timeout"},
+	dberrors.KindConnection:           {http.StatusServiceUnavailable, "Database unavailable"},
+	dberrors.KindResourceExhausted:    {http.StatusServiceUnavailable, "Database resources exhausted"},
+	dberrors.KindReadOnly:             {http.StatusServiceUnavailable, "Database is read only"},
+	dberrors.KindTimeout:              {http.StatusGatewayTimeout, "Timeout"},
+	dberrors.KindCanceled:             {http.StatusServiceUnavailable, "Canceled"},
+	dberrors.KindPermissionDenied:     {http.StatusForbidden, "Permission denied"},
+	dberrors.KindAuthentication:       {http.StatusInternalServerError, "Database authentication failed"},
+	dberrors.KindUndefinedObject:      {http.StatusInternalServerError, "Undefined database object"},
+	dberrors.KindSyntax:               {http.StatusInternalServerError, "Syntax error"},
+	dberrors.KindAbortedTransaction:   {http.StatusInternalServerError, "Aborted transaction"},
+}
+
+// TypeURI TypeURI export
+// Returns the problem type URI of kind, e.g. UNIQUE_VIOLATION => urn:dberrors:problem:unique-violation
+func TypeURI(baseURI string, kind dberrors.Kind) string {
+	if baseURI == "" {
+		baseURI = DefaultBaseURI
+	}
+
+	return baseURI + strings.Replace(strings.ToLower(string(kind)), "_", "-", -1)
+}
+
+// New New export
+// Builds the problem for err, which is classified with dberrors.Parse.
+// Errors which are not database errors result in an internal server error problem.
+func New(err error, opts *Options) *Problem {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	parsedErr := dberrors.Parse(err)
+	kind := dberrors.KindOf(parsedErr)
+
+	m, ok := mappings[kind]
+
+	var problem *Problem
+	if ok {
+		problem = &Problem{
+			Type:   TypeURI(opts.BaseURI, kind),
+			Title:  m.title,
+			Status: m.status,
+		}
+	} else {
+		problem = &Problem{
+			Type:   "about:blank",
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	if ok {
+		if status, ok := opts.Statuses[kind]; ok {
+			problem.Status = status
+		}
+
+		if opts.IncludeIdentifiers {
+			problem.Table, problem.Constraint = identifiers(parsedErr)
+		}
+
+		problem.InvalidParams = invalidParams(parsedErr, opts)
+	}
+
+	// Rules registered with a dberrors.Registry take precedence
+	var constraintError *dberrors.ConstraintError
+	if errors.As(err, &constraintError) {
+		if constraintError.HTTPStatus != 0 {
+			problem.Status = constraintError.HTTPStatus
+		}
+
+		if constraintError.Field != "" {
+			problem.InvalidParams = []InvalidParam{{Name: constraintError.Field, Reason: constraintError.MessageKey}}
+		}
+	}
+
+	if opts.Customize != nil {
+		opts.Customize(err, problem)
+	}
+
+	return problem
+}
+
+// Write Write export
+// Writes the problem for err as the response
+func Write(w http.ResponseWriter, err error, opts *Options) {
+	writeProblem(w, New(err, opts))
+}
+
+// HandlerFunc HandlerFunc export
+// A http handler which returns an error
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// Handler Handler export
+// Adapts fn to a http.Handler which writes the problem for the returned error.
+// The request path is used as the problem instance.
+func Handler(fn HandlerFunc, opts *Options) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := fn(w, r)
+		if err == nil {
+			return
+		}
+
+		problem := New(err, opts)
+		if problem.Instance == "" {
+			problem.Instance = r.URL.Path
+		}
+
+		writeProblem(w, problem)
+	})
+}
+
+func writeProblem(w http.ResponseWriter, problem *Problem) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(problem.Status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
+
+func identifiers(err error) (string, string) {
+	var uniqueViolationError *dberrors.UniqueViolationError
+	if errors.As(err, &uniqueViolationError) {
+		return uniqueViolationError.Table, uniqueViolationError.Constraint
+	}
+
+	var foreignKeyViolationError *dberrors.ForeignKeyViolationError
+	if errors.As(err, &foreignKeyViolationError) {
+		return foreignKeyViolationError.Table, foreignKeyViolationError.Constraint
+	}
+
+	var checkViolationError *dberrors.CheckViolationError
+	if errors.As(err, &checkViolationError) {
+		return checkViolationError.Table, checkViolationError.Constraint
+	}
+
+	var notNullViolationError *dberrors.NotNullViolationError
+	if errors.As(err, &notNullViolationError) {
+		return notNullViolationError.Table, ""
+	}
+
+	var dataError *dberrors.DataError
+	if errors.As(err, &dataError) {
+		return dataError.Table, ""
+	}
+
+	return "", ""
+}
+
+func invalidParams(err error, opts *Options) []InvalidParam {
+	var fieldErrors map[string][]string
+
+	if opts.Model != nil {
+		fieldErrors = dberrors.FieldErrors(err, opts.Model)
+	} else if opts.IncludeIdentifiers {
+		fieldErrors = dberrors.ColumnErrors(err)
+	}
+
+	names := make([]string, 0, len(fieldErrors))
+	for name := range fieldErrors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var params []InvalidParam
+	for _, name := range names {
+		for _, reason := range fieldErrors[name] {
+			params = append(params, InvalidParam{Name: name, Reason: reason})
+		}
+	}
+
+	return params
+}
@@ -0,0 +1,156 @@
+package httperr_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/httperr"
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	Email     string `db:"email" json:"email"`
+	FirstName string `db:"first_name" json:"firstName"`
+}
+
+func TestNew(t *testing.T) {
+	native := errors.New("native")
+
+	uniqueViolationError := &dberrors.UniqueViolationError{
+		Table:      "users",
+		Column:     "email",
+		Constraint: "users_email_key",
+		DbError:    dberrors.NewDbError(native, dberrors.POSTGRES),
+	}
+
+	for _, tc := range []struct {
+		name     string
+		err      error
+		opts     *httperr.Options
+		expected *httperr.Problem
+	}{{
+		name: "identifiers hidden by default",
+		err:  uniqueViolationError,
+		expected: &httperr.Problem{
+			Type:   "urn:dberrors:problem:unique-violation",
+			Title:  "Unique violation",
+			Status: http.StatusConflict,
+		},
+	}, {
+		name: "include identifiers",
+		err:  fmt.Errorf("create user: %w", uniqueViolationError),
+		opts: &httperr.Options{IncludeIdentifiers: true},
+		expected: &httperr.Problem{
+			Type:          "urn:dberrors:problem:unique-violation",
+			Title:         "Unique violation",
+			Status:        http.StatusConflict,
+			InvalidParams: []httperr.InvalidParam{{Name: "email", Reason: dberrors.FieldUnique}},
+			Table:         "users",
+			Constraint:    "users_email_key",
+		},
+	}, {
+		name: "model",
+		err: &dberrors.NotNullViolationError{
+			Table:   "users",
+			Column:  "first_name",
+			DbError: dberrors.NewDbError(native, dberrors.MYSQL),
+		},
+		opts: &httperr.Options{Model: user{}, BaseURI: "https://example.com/problems/"},
+		expected: &httperr.Problem{
+			Type:          "https://example.com/problems/not-null-violation",
+			Title:         "Not null violation",
+			Status:        http.StatusUnprocessableEntity,
+			InvalidParams: []httperr.InvalidParam{{Name: "firstName", Reason: dberrors.FieldRequired}},
+		},
+	}, {
+		name: "status override",
+		err:  &dberrors.DeadlockError{DbError: dberrors.NewDbError(native, dberrors.MSSQL)},
+		opts: &httperr.Options{Statuses: map[dberrors.Kind]int{dberrors.KindDeadlock: http.StatusServiceUnavailable}},
+		expected: &httperr.Problem{
+			Type:   "urn:dberrors:problem:deadlock",
+			Title:  "Deadlock",
+			Status: http.StatusServiceUnavailable,
+		},
+	}, {
+		name: "customize",
+		err:  &dberrors.TimeoutError{Source: dberrors.ServerSource, DbError: dberrors.NewDbError(native, dberrors.POSTGRES)},
+		opts: &httperr.Options{Customize: func(err error, problem *httperr.Problem) {
+			problem.Detail = "try again later"
+		}},
+		expected: &httperr.Problem{
+			Type:   "urn:dberrors:problem:timeout",
+			Title:  "Timeout",
+			Status: http.StatusGatewayTimeout,
+			Detail: "try again later",
+		},
+	}, {
+		name: "not a database error",
+		err:  errors.New("boom"),
+		expected: &httperr.Problem{
+			Type:   "about:blank",
+			Title:  "Internal Server Error",
+			Status: http.StatusInternalServerError,
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, httperr.New(tc.err, tc.opts))
+		})
+	}
+}
+
+func TestNewConstraintError(t *testing.T) {
+	registry := dberrors.NewRegistry()
+	registry.Register(dberrors.ConstraintRule{
+		Constraint: "users_email_key",
+		Field:      "email",
+		MessageKey: "email.taken",
+		HTTPStatus: http.StatusBadRequest,
+	})
+
+	err := registry.Resolve(&dberrors.UniqueViolationError{
+		Table:      "users",
+		Column:     "email",
+		Constraint: "users_email_key",
+		DbError:    dberrors.NewDbError(errors.New("native"), dberrors.POSTGRES),
+	})
+
+	assert.Equal(t, &httperr.Problem{
+		Type:          "urn:dberrors:problem:unique-violation",
+		Title:         "Unique violation",
+		Status:        http.StatusBadRequest,
+		InvalidParams: []httperr.InvalidParam{{Name: "email", Reason: "email.taken"}},
+	}, httperr.New(err, nil))
+}
+
+func TestHandler(t *testing.T) {
+	handler := httperr.Handler(func(w http.ResponseWriter, r *http.Request) error {
+		return &dberrors.ForeignKeyViolationError{
+			Table:      "orders",
+			Constraint: "orders_user_id_fkey",
+			DbError:    dberrors.NewDbError(errors.New("native"), dberrors.POSTGRES),
+		}
+	}, nil)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/orders", nil))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, httperr.ContentType, w.Header().Get("Content-Type"))
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"type":     "urn:dberrors:problem:foreign-key-violation",
+		"title":    "Foreign key violation",
+		"status":   float64(http.StatusConflict),
+		"instance": "/orders",
+	}, body)
+}
@@ -0,0 +1,131 @@
+package aborted_transaction_error
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/internal/dialect"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stackworx-go/dberrors/internal"
+)
+
+var table = "theTable"
+
+var ddl = internal.DDL{
+	Tables: []internal.Table{{
+		Name: table,
+	}},
+	Postgres: []string{fmt.Sprintf(`
+create table "%s"
+(
+    "id"    serial primary key,
+    "value" integer unique
+);`, table)},
+	Sqlite3: []string{fmt.Sprintf(`
+create table %s
+(
+    id    integer not null primary key autoincrement,
+    value integer unique
+);`, table)},
+	Mssql: []string{fmt.Sprintf(`
+CREATE TABLE [%s]
+(
+    [id]    int identity(1,1) not null primary key,
+    [value] int unique
+);`, table)},
+	Mysql: []string{fmt.Sprintf(`
+create table %s
+(
+    id    int unsigned not null auto_increment primary key,
+    value int unique
+);`, table)},
+}
+
+func insert(tc internal.TestCase, execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, value int) error {
+	_, err := execer.Exec(fmt.Sprintf("insert into %s (%s) values (%s)",
+		internal.Quote(tc.Dialect, table),
+		internal.Quote(tc.Dialect, "value"),
+		internal.Placeholder(tc.Dialect, 1),
+	), value)
+
+	return err
+}
+
+func TestStatementAfterError(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			if tc.Dialect != dialect.POSTGRES {
+				t.Skip("only postgres aborts the transaction after an error")
+			}
+
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			tx, err := tc.DB.Begin()
+			assert.NoError(t, err)
+			defer func() { _ = tx.Rollback() }()
+
+			assert.NoError(t, insert(tc, tx, 1))
+			assert.Error(t, insert(tc, tx, 1))
+
+			err = insert(tc, tx, 2)
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			assert.Equal(t, &dberrors.AbortedTransactionError{
+				DbError: internal.NewDbError(tc.Dialect, err),
+			}, parsedErr)
+		})
+	}
+}
+
+func TestWithSavepoint(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			ctx := context.Background()
+
+			tx, err := tc.DB.BeginTx(ctx, nil)
+			assert.NoError(t, err)
+			defer func() { _ = tx.Rollback() }()
+
+			assert.NoError(t, insert(tc, tx, 1))
+
+			err = dberrors.WithSavepoint(ctx, tx, tc.Dialect, func() error {
+				return insert(tc, tx, 1)
+			})
+
+			var uniqueViolationError *dberrors.UniqueViolationError
+			assert.True(t, errors.As(internal.ParseError(tc.Dialect, err), &uniqueViolationError))
+
+			assert.NoError(t, insert(tc, tx, 2))
+			assert.NoError(t, tx.Commit())
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
+	_ = flag.String("mysql", "", "Run Mysql Tests")
+	_ = flag.String("postgres", "", "Run Postgres Test")
+	flag.Parse()
+	os.Exit(m.Run())
+}
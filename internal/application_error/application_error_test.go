@@ -0,0 +1,95 @@
+package application_error
+
+import (
+	"flag"
+	"fmt"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/internal/dialect"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stackworx-go/dberrors/internal"
+)
+
+var table = "theTable"
+
+var ddl = internal.DDL{
+	Tables: []internal.Table{{
+		Name: table,
+	}},
+	Postgres: []string{fmt.Sprintf(`
+create table "%s"
+(
+    "id"     serial primary key,
+    "amount" integer
+);`, table)},
+	Sqlite3: []string{fmt.Sprintf(`
+create table %s
+(
+    id     integer not null primary key autoincrement,
+    amount integer
+);`, table), fmt.Sprintf(`
+create trigger check_amount before insert on %s
+begin
+    select raise(abort, 'insufficient funds');
+end;`, table)},
+	Mssql: []string{fmt.Sprintf(`
+CREATE TABLE [%s]
+(
+    [id]     int identity(1,1) not null primary key,
+    [amount] int
+);`, table)},
+	Mysql: []string{`drop procedure if exists raise_error;`, `
+create procedure raise_error()
+begin
+    signal sqlstate '45000' set message_text = 'insufficient funds';
+end;`},
+}
+
+func TestRaise(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			var err error
+			var code string
+
+			if tc.Dialect == dialect.POSTGRES {
+				_, err = tc.DB.Exec(`do $$ begin raise exception 'insufficient funds'; end $$`)
+				code = "P0001"
+			} else if tc.Dialect == dialect.MSSQL {
+				_, err = tc.DB.Exec(`throw 50001, 'insufficient funds', 1`)
+				code = "50001"
+			} else if tc.Dialect == dialect.MYSQL {
+				_, err = tc.DB.Exec(`call raise_error()`)
+				code = "1644"
+			} else {
+				_, err = tc.DB.Exec(fmt.Sprintf("insert into %s (amount) values (1)", table))
+				code = "1811"
+			}
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			assert.Equal(t, &dberrors.ApplicationError{
+				Code:    code,
+				Message: "insufficient funds",
+				DbError: internal.NewDbError(tc.Dialect, err),
+			}, parsedErr)
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
+	_ = flag.String("mysql", "", "Run Mysql Tests")
+	_ = flag.String("postgres", "", "Run Postgres Test")
+	flag.Parse()
+	os.Exit(m.Run())
+}
@@ -80,13 +80,13 @@ func TestInsert(t *testing.T) {
 				assert.Equal(t, &dberrors.CheckViolationError{
 					Table:      table,
 					Constraint: "theTable_value1_check",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			} else {
 				assert.Equal(t, &dberrors.CheckViolationError{
 					Table:      "",
 					Constraint: "",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			}
 		})
@@ -126,13 +126,13 @@ func TestUpdate(t *testing.T) {
 				assert.Equal(t, &dberrors.CheckViolationError{
 					Table:      table,
 					Constraint: "theTable_value1_check",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			} else {
 				assert.Equal(t, &dberrors.CheckViolationError{
 					Table:      "",
 					Constraint: "",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			}
 		})
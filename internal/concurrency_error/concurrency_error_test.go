@@ -0,0 +1,158 @@
+package concurrency_error
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/internal/dialect"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stackworx-go/dberrors/internal"
+)
+
+var table = "theTable"
+
+var ddl = internal.DDL{
+	Tables: []internal.Table{{
+		Name: table,
+	}},
+	Postgres: []string{fmt.Sprintf(`
+create table "%[1]s"
+(
+    "id"    integer primary key,
+    "value" integer
+);`, table), fmt.Sprintf(`insert into "%s" ("id", "value") values (1, 0), (2, 0);`, table)},
+	Sqlite3: []string{fmt.Sprintf(`
+create table %s
+(
+    id    integer not null primary key,
+    value integer
+);`, table), fmt.Sprintf(`insert into %s (id, value) values (1, 0), (2, 0);`, table)},
+	Mssql: []string{fmt.Sprintf(`
+CREATE TABLE [%s]
+(
+    [id]    int not null primary key,
+    [value] int
+);`, table), fmt.Sprintf(`insert into [%s] ([id], [value]) values (1, 0), (2, 0);`, table)},
+	Mysql: []string{fmt.Sprintf(`
+create table %s
+(
+    id    int unsigned not null primary key,
+    value int
+);`, table), fmt.Sprintf(`insert into %s (id, value) values (1, 0), (2, 0);`, table)},
+}
+
+func update(tc internal.TestCase, tx *sql.Tx, id int) error {
+	_, err := tx.Exec(fmt.Sprintf("update %s set %[2]s = %[2]s + 1 where %s = %s",
+		internal.Quote(tc.Dialect, table),
+		internal.Quote(tc.Dialect, "value"),
+		internal.Quote(tc.Dialect, "id"),
+		internal.Placeholder(tc.Dialect, 1),
+	), id)
+
+	return err
+}
+
+func TestDeadlock(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			if tc.Dialect == dialect.SQLITE3 {
+				t.Skip("SQLITE only allows a single writer")
+			}
+
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			tx1, err := tc.DB.Begin()
+			assert.NoError(t, err)
+			defer func() { _ = tx1.Rollback() }()
+
+			tx2, err := tc.DB.Begin()
+			assert.NoError(t, err)
+			defer func() { _ = tx2.Rollback() }()
+
+			assert.NoError(t, update(tc, tx1, 1))
+			assert.NoError(t, update(tc, tx2, 2))
+
+			errs := make(chan error, 1)
+			go func() {
+				errs <- update(tc, tx1, 2)
+			}()
+
+			err = update(tc, tx2, 1)
+			if err == nil {
+				err = <-errs
+			}
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			assert.Equal(t, &dberrors.DeadlockError{
+				DbError: internal.NewDbError(tc.Dialect, err),
+			}, parsedErr)
+			assert.True(t, dberrors.IsRetryable(parsedErr))
+		})
+	}
+}
+
+func TestSerializationFailure(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			if tc.Dialect != dialect.POSTGRES {
+				t.Skip("pending")
+			}
+
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			tx, err := tc.DB.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead})
+			assert.NoError(t, err)
+			defer func() { _ = tx.Rollback() }()
+
+			// Take the snapshot before the concurrent update commits
+			var value int
+			assert.NoError(t, tx.QueryRow(fmt.Sprintf("select %s from %s where %s = 1",
+				internal.Quote(tc.Dialect, "value"),
+				internal.Quote(tc.Dialect, table),
+				internal.Quote(tc.Dialect, "id"),
+			)).Scan(&value))
+
+			_, err = tc.DB.Exec(fmt.Sprintf("update %s set %[2]s = %[2]s + 1 where %s = 1",
+				internal.Quote(tc.Dialect, table),
+				internal.Quote(tc.Dialect, "value"),
+				internal.Quote(tc.Dialect, "id"),
+			))
+			assert.NoError(t, err)
+
+			err = update(tc, tx, 1)
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			assert.Equal(t, &dberrors.SerializationFailureError{
+				DbError: internal.NewDbError(tc.Dialect, err),
+			}, parsedErr)
+			assert.True(t, dberrors.IsRetryable(parsedErr))
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
+	_ = flag.String("mysql", "", "Run Mysql Tests")
+	_ = flag.String("postgres", "", "Run Postgres Test")
+	flag.Parse()
+	os.Exit(m.Run())
+}
@@ -0,0 +1,56 @@
+package connection_error
+
+import (
+	"flag"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/internal/dialect"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stackworx-go/dberrors/internal"
+)
+
+var ddl = internal.DDL{}
+
+func TestTerminatedConnection(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			if tc.Dialect == dialect.SQLITE3 {
+				t.Skip("SQLITE is embedded and has no connection to lose")
+			}
+
+			if tc.Dialect != dialect.POSTGRES {
+				t.Skip("connections can not be terminated reliably, see the parser tests")
+			}
+
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			_, err := tc.DB.Exec("select pg_terminate_backend(pg_backend_pid())")
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			// pq reports the FATAL admin_shutdown error as driver.ErrBadConn,
+			// whether the statement executed can not be told
+			assert.Equal(t, &dberrors.ConnectionError{
+				MaybeExecuted: true,
+				DbError:       internal.NewDbError(tc.Dialect, err),
+			}, parsedErr)
+			assert.False(t, dberrors.IsRetryable(parsedErr))
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
+	_ = flag.String("mysql", "", "Run Mysql Tests")
+	_ = flag.String("postgres", "", "Run Postgres Test")
+	flag.Parse()
+	os.Exit(m.Run())
+}
@@ -15,9 +15,13 @@ import (
 
 var table = "theTable"
 
+var strictTable = "theStrictTable"
+
 var ddl = internal.DDL{
 	Tables: []internal.Table{{
 		Name: table,
+	}, {
+		Name: strictTable,
 	}},
 	Postgres: []string{fmt.Sprintf(`
 create table "%s"
@@ -37,7 +41,12 @@ create table %s
     date_time datetime,
     string    varchar(10),
     int       integer
-);`, table)},
+);`, table), fmt.Sprintf(`
+create table %s
+(
+    id  integer not null primary key autoincrement,
+    int integer
+) strict;`, strictTable)},
 	// Primary key cannot be null in MSSQL
 	Mssql: []string{fmt.Sprintf(`
 CREATE TABLE [%s]
@@ -92,9 +101,19 @@ func TestDateRandomText(t *testing.T) {
 
 			parsedErr := internal.ParseError(tc.Dialect, err)
 
-			assert.Equal(t, &dberrors.DataError{
-				DbError: dberrors.NewDbError(err, tc.Dialect),
-			}, parsedErr)
+			if tc.Dialect == dialect.MYSQL {
+				assert.Equal(t, &dberrors.DataError{
+					Reason:  dberrors.InvalidDatetime,
+					Column:  "date",
+					Row:     1,
+					DbError: internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			} else {
+				assert.Equal(t, &dberrors.DataError{
+					Reason:  dberrors.InvalidDatetime,
+					DbError: internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			}
 		})
 	}
 }
@@ -108,11 +127,106 @@ func TestDateTimeInvalidDate(t *testing.T) {
 }
 
 func TestStringTooLong(t *testing.T) {
-	t.Skip("pending")
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			if tc.Dialect == dialect.SQLITE3 {
+				// https://www.sqlite.org/faq.html (9)
+				t.Skip("SQLITE does not enforce varchar length")
+			}
+
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			var err error
+
+			if tc.Dialect == dialect.MSSQL {
+				_, err = tc.DB.Exec(fmt.Sprintf("insert into %s (%s) values (@p1)",
+					internal.Quote(tc.Dialect, table), internal.Quote(tc.Dialect, "string")), "aaaaaaaaaaa")
+			} else if tc.Dialect == dialect.MYSQL {
+				_, err = tc.DB.Exec(fmt.Sprintf("insert into %s (%s) values (?)",
+					internal.Quote(tc.Dialect, table), internal.Quote(tc.Dialect, "string")), "aaaaaaaaaaa")
+			} else {
+				_, err = tc.DB.Exec(fmt.Sprintf("insert into %s (%s) values ($1)",
+					internal.Quote(tc.Dialect, table), internal.Quote(tc.Dialect, "string")), "aaaaaaaaaaa")
+			}
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			if tc.Dialect == dialect.MYSQL {
+				assert.Equal(t, &dberrors.DataError{
+					Reason:  dberrors.StringTruncation,
+					Column:  "string",
+					Row:     1,
+					DbError: internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			} else {
+				// mssql 2017 reports 8152 without table or column information
+				assert.Equal(t, &dberrors.DataError{
+					Reason:  dberrors.StringTruncation,
+					DbError: internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			}
+		})
+	}
 }
 
 func TestIntegerInvalid(t *testing.T) {
-	t.Skip("pending")
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			var err error
+
+			if tc.Dialect == dialect.MSSQL {
+				_, err = tc.DB.Exec(fmt.Sprintf("insert into %s (%s) values (@p1)",
+					internal.Quote(tc.Dialect, table), internal.Quote(tc.Dialect, "int")), "lol")
+			} else if tc.Dialect == dialect.MYSQL {
+				_, err = tc.DB.Exec(fmt.Sprintf("insert into %s (%s) values (?)",
+					internal.Quote(tc.Dialect, table), internal.Quote(tc.Dialect, "int")), "lol")
+			} else if tc.Dialect == dialect.SQLITE3 {
+				// Only STRICT tables reject values which do not match the column type
+				_, err = tc.DB.Exec(fmt.Sprintf("insert into %s (%s) values ($1)",
+					internal.Quote(tc.Dialect, strictTable), internal.Quote(tc.Dialect, "int")), "lol")
+			} else {
+				_, err = tc.DB.Exec(fmt.Sprintf("insert into %s (%s) values ($1)",
+					internal.Quote(tc.Dialect, table), internal.Quote(tc.Dialect, "int")), "lol")
+			}
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			if tc.Dialect == dialect.MYSQL {
+				assert.Equal(t, &dberrors.DataError{
+					Reason:  dberrors.InvalidTextRepresentation,
+					Column:  "int",
+					Row:     1,
+					DbError: internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			} else if tc.Dialect == dialect.SQLITE3 {
+				assert.Equal(t, &dberrors.DataError{
+					Reason:  dberrors.InvalidTextRepresentation,
+					Table:   strictTable,
+					Column:  "int",
+					DbError: internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			} else {
+				assert.Equal(t, &dberrors.DataError{
+					Reason:  dberrors.InvalidTextRepresentation,
+					DbError: internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			}
+		})
+	}
 }
 
 func TestMain(m *testing.M) {
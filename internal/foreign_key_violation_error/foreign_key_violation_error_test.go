@@ -113,28 +113,28 @@ func TestInsert(t *testing.T) {
 					Table:      "target",
 					Constraint: "source_foreign_key_foreign",
 					Schema:     "dbo",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			} else if tc.Dialect == dialect.POSTGRES {
 				assert.Equal(t, &dberrors.ForeignKeyViolationError{
 					Table:      "source",
 					Schema:     "public",
 					Constraint: "source_foreign_key_foreign",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			} else if tc.Dialect == dialect.MYSQL {
 				assert.Equal(t, &dberrors.ForeignKeyViolationError{
 					Table:      "source",
 					Schema:     "db_errors_test",
 					Constraint: "source_foreign_key_foreign",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			} else {
 				assert.Equal(t, &dberrors.ForeignKeyViolationError{
 					Table:      "",
 					Schema:     "",
 					Constraint: "",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			}
 		})
@@ -88,27 +88,27 @@ func TestInsert(t *testing.T) {
 				assert.Equal(t, &dberrors.NotNullViolationError{
 					Table:   table,
 					Column:  "not_nullable",
-					DbError: dberrors.NewDbError(err, tc.Dialect),
+					DbError: internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			} else if tc.Dialect == dialect.MSSQL {
 				assert.Equal(t, &dberrors.NotNullViolationError{
 					Table:   table,
 					Column:  "not_nullable",
 					Schema:  "dbo",
-					DbError: dberrors.NewDbError(err, tc.Dialect),
+					DbError: internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			} else if tc.Dialect == dialect.MYSQL {
 				assert.Equal(t, &dberrors.NotNullViolationError{
 					Table:   "",
 					Column:  "not_nullable",
 					Schema:  "",
-					DbError: dberrors.NewDbError(err, tc.Dialect),
+					DbError: internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			} else {
 				assert.Equal(t, &dberrors.NotNullViolationError{
 					Table:   table,
 					Column:  "not_nullable",
-					DbError: dberrors.NewDbError(err, tc.Dialect),
+					DbError: internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			}
 		})
@@ -0,0 +1,74 @@
+package permission_error
+
+import (
+	"flag"
+	"fmt"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/internal/dialect"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stackworx-go/dberrors/internal"
+)
+
+var table = "theTable"
+var role = "db_errors_no_privileges"
+
+var ddl = internal.DDL{
+	Tables: []internal.Table{{
+		Name: table,
+	}},
+	Postgres: []string{fmt.Sprintf(`
+create table "%s"
+(
+    "id"    serial primary key,
+    "value" integer
+);`, table),
+		fmt.Sprintf(`drop role if exists %s;`, role),
+		fmt.Sprintf(`create role %s;`, role)},
+}
+
+func TestInsertWithoutPrivilege(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			if tc.Dialect != dialect.POSTGRES {
+				t.Skip("pending")
+			}
+
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			tx, err := tc.DB.Begin()
+			assert.NoError(t, err)
+			defer func() { _ = tx.Rollback() }()
+
+			_, err = tx.Exec(fmt.Sprintf("set local role %s", role))
+			assert.NoError(t, err)
+
+			_, err = tx.Exec(fmt.Sprintf("insert into %s (%s) values ($1)",
+				internal.Quote(tc.Dialect, table), internal.Quote(tc.Dialect, "value")), 1)
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			assert.Equal(t, &dberrors.PermissionDeniedError{
+				ObjectType: "table",
+				Object:     table,
+				DbError:    internal.NewDbError(tc.Dialect, err),
+			}, parsedErr)
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
+	_ = flag.String("mysql", "", "Run Mysql Tests")
+	_ = flag.String("postgres", "", "Run Postgres Test")
+	flag.Parse()
+	os.Exit(m.Run())
+}
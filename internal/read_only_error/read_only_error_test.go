@@ -0,0 +1,77 @@
+package read_only_error
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/internal/dialect"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stackworx-go/dberrors/internal"
+)
+
+var table = "theTable"
+
+var ddl = internal.DDL{
+	Tables: []internal.Table{{
+		Name: table,
+	}},
+	Postgres: []string{fmt.Sprintf(`
+create table "%s"
+(
+    "id"    serial primary key,
+    "value" integer
+);`, table)},
+	Mysql: []string{fmt.Sprintf(`
+create table %s
+(
+    id    int unsigned not null auto_increment primary key,
+    value int
+);`, table)},
+}
+
+func TestInsertInReadOnlyTransaction(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			if tc.Dialect == dialect.MSSQL || tc.Dialect == dialect.SQLITE3 {
+				t.Skip("read only transactions are not supported")
+			}
+
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			tx, err := tc.DB.BeginTx(context.Background(), &sql.TxOptions{ReadOnly: true})
+			assert.NoError(t, err)
+			defer func() { _ = tx.Rollback() }()
+
+			_, err = tx.Exec(fmt.Sprintf("insert into %s (%s) values (%s)",
+				internal.Quote(tc.Dialect, table),
+				internal.Quote(tc.Dialect, "value"),
+				internal.Placeholder(tc.Dialect, 1),
+			), 1)
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			assert.Equal(t, &dberrors.ReadOnlyError{
+				DbError: internal.NewDbError(tc.Dialect, err),
+			}, parsedErr)
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
+	_ = flag.String("mysql", "", "Run Mysql Tests")
+	_ = flag.String("postgres", "", "Run Postgres Test")
+	flag.Parse()
+	os.Exit(m.Run())
+}
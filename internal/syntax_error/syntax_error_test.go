@@ -0,0 +1,64 @@
+package syntax_error
+
+import (
+	"flag"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/internal/dialect"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stackworx-go/dberrors/internal"
+)
+
+var ddl = internal.DDL{}
+
+func TestMisplacedKeyword(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	query := "select * from where"
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			_, err := tc.DB.Exec(query)
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			if tc.Dialect == dialect.POSTGRES {
+				assert.Equal(t, &dberrors.SyntaxError{
+					Token:    "where",
+					Position: 15,
+					DbError:  internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			} else if tc.Dialect == dialect.SQLITE3 {
+				assert.Equal(t, &dberrors.SyntaxError{
+					Token:   "where",
+					DbError: internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			} else {
+				assert.Equal(t, &dberrors.SyntaxError{
+					Token:   "where",
+					Line:    1,
+					DbError: internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			}
+
+			assert.Equal(t, "LINE 1: select * from where\n                      ^",
+				parsedErr.(*dberrors.SyntaxError).Caret(query))
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
+	_ = flag.String("mysql", "", "Run Mysql Tests")
+	_ = flag.String("postgres", "", "Run Postgres Test")
+	flag.Parse()
+	os.Exit(m.Run())
+}
@@ -7,6 +7,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/stackworx-go/dberrors"
 	"github.com/stackworx-go/dberrors/internal/dialect"
 	"github.com/stackworx-go/dberrors/parser/mssql"
 	"github.com/stackworx-go/dberrors/parser/mysql"
@@ -199,16 +200,37 @@ func Quote(d dialect.Dialect, identifier string) string {
 	}
 }
 
+// Placeholder Placeholder export
+// Returns the bind parameter placeholder for the n-th (1-based) parameter
+func Placeholder(d dialect.Dialect, n int) string {
+	switch d {
+	case dialect.POSTGRES, dialect.SQLITE3:
+		return fmt.Sprintf("$%d", n)
+	case dialect.MSSQL:
+		return fmt.Sprintf("@p%d", n)
+	case dialect.MYSQL:
+		return "?"
+	default:
+		panic(fmt.Errorf("invalid dialect: %s", d))
+	}
+}
+
 func ParseError(d dialect.Dialect, err error) error {
+	return dberrors.ParseDialect(err, d)
+}
+
+// NewDbError NewDbError export
+// Builds the DbError, including diagnostics, that a parser embeds for err
+func NewDbError(d dialect.Dialect, err error) dberrors.DbError {
 	switch d {
 	case dialect.POSTGRES:
-		return postgres.Parse(err)
+		return dberrors.NewDbErrorWithDiagnostics(err, d, postgres.Diagnostics(err))
 	case dialect.MSSQL:
-		return mssql.Parse(err)
+		return dberrors.NewDbErrorWithDiagnostics(err, d, mssql.Diagnostics(err))
 	case dialect.MYSQL:
-		return mysql.Parse(err)
+		return dberrors.NewDbErrorWithDiagnostics(err, d, mysql.Diagnostics(err))
 	case dialect.SQLITE3:
-		return sqlite.Parse(err)
+		return dberrors.NewDbErrorWithDiagnostics(err, d, sqlite.Diagnostics(err))
 	default:
 		panic(fmt.Errorf("invalid dialect: %s", d))
 	}
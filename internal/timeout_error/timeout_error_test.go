@@ -0,0 +1,56 @@
+package timeout_error
+
+import (
+	"flag"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/internal/dialect"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stackworx-go/dberrors/internal"
+)
+
+var ddl = internal.DDL{}
+
+func TestStatementTimeout(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			if tc.Dialect != dialect.POSTGRES {
+				t.Skip("pending")
+			}
+
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			tx, err := tc.DB.Begin()
+			assert.NoError(t, err)
+			defer func() { _ = tx.Rollback() }()
+
+			_, err = tx.Exec("set local statement_timeout = 10")
+			assert.NoError(t, err)
+
+			_, err = tx.Exec("select pg_sleep(1)")
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			assert.Equal(t, &dberrors.TimeoutError{
+				Source:  dberrors.ServerSource,
+				DbError: internal.NewDbError(tc.Dialect, err),
+			}, parsedErr)
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
+	_ = flag.String("mysql", "", "Run Mysql Tests")
+	_ = flag.String("postgres", "", "Run Postgres Test")
+	flag.Parse()
+	os.Exit(m.Run())
+}
@@ -0,0 +1,107 @@
+package undefined_object_error
+
+import (
+	"flag"
+	"fmt"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/internal/dialect"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stackworx-go/dberrors/internal"
+)
+
+var table = "theTable"
+
+var ddl = internal.DDL{
+	Tables: []internal.Table{{
+		Name: table,
+	}},
+	Postgres: []string{fmt.Sprintf(`
+create table "%s"
+(
+    "id" serial primary key
+);`, table)},
+	Sqlite3: []string{fmt.Sprintf(`
+create table %s
+(
+    id integer not null primary key autoincrement
+);`, table)},
+	Mssql: []string{fmt.Sprintf(`
+CREATE TABLE [%s]
+(
+    [id] int identity(1,1) not null primary key
+);`, table)},
+	Mysql: []string{fmt.Sprintf(`
+create table %s
+(
+    id int unsigned not null auto_increment primary key
+);`, table)},
+}
+
+func TestMissingTable(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			_, err := tc.DB.Exec(fmt.Sprintf("select * from %s", internal.Quote(tc.Dialect, "missing_table")))
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			if tc.Dialect == dialect.MYSQL {
+				assert.Equal(t, &dberrors.UndefinedObjectError{
+					ObjectType: "table",
+					Name:       "missing_table",
+					Schema:     "db_errors_test",
+					DbError:    internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			} else {
+				assert.Equal(t, &dberrors.UndefinedObjectError{
+					ObjectType: "table",
+					Name:       "missing_table",
+					DbError:    internal.NewDbError(tc.Dialect, err),
+				}, parsedErr)
+			}
+		})
+	}
+}
+
+func TestMissingColumn(t *testing.T) {
+	testCases, cleanup := internal.BuildTestCases(t, ddl)
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(string(tc.Dialect), func(t *testing.T) {
+			tc.SetUp(t)
+			defer tc.TearDown(t)
+
+			_, err := tc.DB.Exec(fmt.Sprintf("select %s from %s",
+				internal.Quote(tc.Dialect, "missing_column"), internal.Quote(tc.Dialect, table)))
+
+			assert.Error(t, err)
+
+			parsedErr := internal.ParseError(tc.Dialect, err)
+
+			assert.Equal(t, &dberrors.UndefinedObjectError{
+				ObjectType: "column",
+				Name:       "missing_column",
+				DbError:    internal.NewDbError(tc.Dialect, err),
+			}, parsedErr)
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
+	_ = flag.String("mysql", "", "Run Mysql Tests")
+	_ = flag.String("postgres", "", "Run Postgres Test")
+	flag.Parse()
+	os.Exit(m.Run())
+}
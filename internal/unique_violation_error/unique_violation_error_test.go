@@ -156,25 +156,30 @@ func TestInsertSingleColumn(t *testing.T) {
 					Schema:     "public",
 					Column:     "i_am_unique_col",
 					Constraint: "thetable_i_am_unique_col_unique",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
+
+				diagnostics := parsedErr.(*dberrors.UniqueViolationError).Diagnostics()
+				assert.Equal(t, "23505", diagnostics.Code)
+				assert.Equal(t, "ERROR", diagnostics.Severity)
+				assert.Equal(t, "_bt_check_unique", diagnostics.Routine)
 			} else if tc.Dialect == dialect.MSSQL {
 				assert.Equal(t, &dberrors.UniqueViolationError{
 					Table:      table,
 					Constraint: "thetable_i_am_unique_col_unique",
 					Schema:     "dbo",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			} else if tc.Dialect == dialect.MYSQL {
 				assert.Equal(t, &dberrors.UniqueViolationError{
 					Constraint: "thetable_i_am_unique_col_unique",
-					DbError:    dberrors.NewDbError(err, tc.Dialect),
+					DbError:    internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			} else {
 				assert.Equal(t, &dberrors.UniqueViolationError{
 					Column:  "i_am_unique_col",
 					Table:   table,
-					DbError: dberrors.NewDbError(err, tc.Dialect),
+					DbError: internal.NewDbError(tc.Dialect, err),
 				}, parsedErr)
 			}
 		})
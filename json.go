@@ -0,0 +1,370 @@
+package dberrors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Standardized errors are encoded as a flat JSON object:
+//
+//	{"kind":"UNIQUE_VIOLATION","dialect":"POSTGRES","code":"23505","error":"pq: duplicate key value ...",
+//	 "table":"users","constraint":"users_email_key","diagnostics":{"severity":"ERROR"}}
+//
+// The native error is not preserved, decoded errors unwrap to an error with the same message.
+// Values in the message are redacted, see ShowValues.
+type errorJSON struct {
+	Kind        Kind         `json:"kind"`
+	Dialect     Dialect      `json:"dialect,omitempty"`
+	Code        string       `json:"code,omitempty"`
+	Error       string       `json:"error,omitempty"`
+	Diagnostics *Diagnostics `json:"diagnostics,omitempty"`
+}
+
+var kinds = map[Kind]func() error{
+	KindDataError:            func() error { return &DataError{} },
+	KindCheckViolation:       func() error { return &CheckViolationError{} },
+	KindForeignKeyViolation:  func() error { return &ForeignKeyViolationError{} },
+	KindNotNullViolation:     func() error { return &NotNullViolationError{} },
+	KindUniqueViolation:      func() error { return &UniqueViolationError{} },
+	KindDeadlock:             func() error { return &DeadlockError{} },
+	KindSerializationFailure: func() error { return &SerializationFailureError{} },
+	KindLockTimeout:          func() error { return &LockTimeoutError{} },
+	KindConnection:           func() error { return &ConnectionError{} },
+	KindTimeout:              func() error { return &TimeoutError{} },
+	KindCanceled:             func() error { return &CanceledError{} },
+	KindPermissionDenied:     func() error { return &PermissionDeniedError{} },
+	KindAuthentication:       func() error { return &AuthenticationError{} },
+	KindUndefinedObject:      func() error { return &UndefinedObjectError{} },
+	KindSyntax:               func() error { return &SyntaxError{} },
+	KindResourceExhausted:    func() error { return &ResourceExhaustedError{} },
+	KindReadOnly:             func() error { return &ReadOnlyError{} },
+	KindAbortedTransaction:   func() error { return &AbortedTransactionError{} },
+	KindApplication:          func() error { return &ApplicationError{} },
+}
+
+// UnmarshalError UnmarshalError export
+// Rebuilds the typed standardized error encoded in data, e.g. *UniqueViolationError for the UNIQUE_VIOLATION kind.
+func UnmarshalError(data []byte) (error, error) {
+	var envelope errorJSON
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+
+	newError, ok := kinds[envelope.Kind]
+	if !ok {
+		return nil, fmt.Errorf("dberrors: unknown error kind %q", envelope.Kind)
+	}
+
+	decoded := newError()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+func marshalError(e describer, fields interface{}) ([]byte, error) {
+	dbError := e.dbError()
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	envelope := errorJSON{
+		Kind:    e.Kind(),
+		Dialect: dbError.dialect,
+		Code:    dbError.diagnostics.Code,
+	}
+
+	if native := e.Unwrap(); native != nil {
+		envelope.Error = native.Error()
+	}
+
+	if diagnostics := dbError.Diagnostics(); diagnostics != (Diagnostics{Code: diagnostics.Code}) {
+		envelope.Diagnostics = &diagnostics
+	}
+
+	data, err = json.Marshal(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	// The envelope takes precedence, e.g. ApplicationError.Code is the native code
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(object)
+}
+
+func unmarshalError(data []byte, kind Kind, dbError *DbError, fields interface{}) error {
+	var envelope errorJSON
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+
+	if envelope.Kind != kind {
+		return fmt.Errorf("dberrors: cannot unmarshal %s error into %s error", envelope.Kind, kind)
+	}
+
+	if err := json.Unmarshal(data, fields); err != nil {
+		return err
+	}
+
+	var diagnostics Diagnostics
+	if envelope.Diagnostics != nil {
+		diagnostics = *envelope.Diagnostics
+	}
+	diagnostics.Code = envelope.Code
+
+	var err error
+	if envelope.Error != "" {
+		err = errors.New(envelope.Error)
+	}
+
+	*dbError = NewDbErrorWithDiagnostics(err, envelope.Dialect, diagnostics)
+
+	return nil
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *DataError) MarshalJSON() ([]byte, error) {
+	type fields DataError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *DataError) UnmarshalJSON(data []byte) error {
+	type fields DataError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *CheckViolationError) MarshalJSON() ([]byte, error) {
+	type fields CheckViolationError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *CheckViolationError) UnmarshalJSON(data []byte) error {
+	type fields CheckViolationError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *ForeignKeyViolationError) MarshalJSON() ([]byte, error) {
+	type fields ForeignKeyViolationError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *ForeignKeyViolationError) UnmarshalJSON(data []byte) error {
+	type fields ForeignKeyViolationError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *NotNullViolationError) MarshalJSON() ([]byte, error) {
+	type fields NotNullViolationError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *NotNullViolationError) UnmarshalJSON(data []byte) error {
+	type fields NotNullViolationError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *UniqueViolationError) MarshalJSON() ([]byte, error) {
+	type fields UniqueViolationError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *UniqueViolationError) UnmarshalJSON(data []byte) error {
+	type fields UniqueViolationError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *DeadlockError) MarshalJSON() ([]byte, error) {
+	type fields DeadlockError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *DeadlockError) UnmarshalJSON(data []byte) error {
+	type fields DeadlockError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *SerializationFailureError) MarshalJSON() ([]byte, error) {
+	type fields SerializationFailureError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *SerializationFailureError) UnmarshalJSON(data []byte) error {
+	type fields SerializationFailureError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *LockTimeoutError) MarshalJSON() ([]byte, error) {
+	type fields LockTimeoutError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *LockTimeoutError) UnmarshalJSON(data []byte) error {
+	type fields LockTimeoutError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *ConnectionError) MarshalJSON() ([]byte, error) {
+	type fields ConnectionError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *ConnectionError) UnmarshalJSON(data []byte) error {
+	type fields ConnectionError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *TimeoutError) MarshalJSON() ([]byte, error) {
+	type fields TimeoutError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *TimeoutError) UnmarshalJSON(data []byte) error {
+	type fields TimeoutError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *CanceledError) MarshalJSON() ([]byte, error) {
+	type fields CanceledError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *CanceledError) UnmarshalJSON(data []byte) error {
+	type fields CanceledError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *PermissionDeniedError) MarshalJSON() ([]byte, error) {
+	type fields PermissionDeniedError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *PermissionDeniedError) UnmarshalJSON(data []byte) error {
+	type fields PermissionDeniedError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *AuthenticationError) MarshalJSON() ([]byte, error) {
+	type fields AuthenticationError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *AuthenticationError) UnmarshalJSON(data []byte) error {
+	type fields AuthenticationError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *UndefinedObjectError) MarshalJSON() ([]byte, error) {
+	type fields UndefinedObjectError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *UndefinedObjectError) UnmarshalJSON(data []byte) error {
+	type fields UndefinedObjectError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *SyntaxError) MarshalJSON() ([]byte, error) {
+	type fields SyntaxError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *SyntaxError) UnmarshalJSON(data []byte) error {
+	type fields SyntaxError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *ResourceExhaustedError) MarshalJSON() ([]byte, error) {
+	type fields ResourceExhaustedError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *ResourceExhaustedError) UnmarshalJSON(data []byte) error {
+	type fields ResourceExhaustedError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *ReadOnlyError) MarshalJSON() ([]byte, error) {
+	type fields ReadOnlyError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *ReadOnlyError) UnmarshalJSON(data []byte) error {
+	type fields ReadOnlyError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *AbortedTransactionError) MarshalJSON() ([]byte, error) {
+	type fields AbortedTransactionError
+	return marshalError(e, (*fields)(e))
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *AbortedTransactionError) UnmarshalJSON(data []byte) error {
+	type fields AbortedTransactionError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
+
+// MarshalJSON MarshalJSON implementation
+func (e *ApplicationError) MarshalJSON() ([]byte, error) {
+	type fields ApplicationError
+	f := fields(*e)
+
+	// The raised message may contain values
+	if !valuesShown() {
+		f.Message = ""
+	}
+
+	return marshalError(e, &f)
+}
+
+// UnmarshalJSON UnmarshalJSON implementation
+func (e *ApplicationError) UnmarshalJSON(data []byte) error {
+	type fields ApplicationError
+	return unmarshalError(data, e.Kind(), &e.DbError, (*fields)(e))
+}
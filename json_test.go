@@ -0,0 +1,83 @@
+package dberrors_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stackworx-go/dberrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	err := &dberrors.UniqueViolationError{
+		Table:      "users",
+		Column:     "email",
+		Constraint: "users_email_key",
+		Schema:     "public",
+		DbError: dberrors.NewDbErrorWithDiagnostics(errors.New("pq: duplicate key value violates unique constraint"), dberrors.POSTGRES, dberrors.Diagnostics{
+			Code:     "23505",
+			Severity: "ERROR",
+			Routine:  "_bt_check_unique",
+		}),
+	}
+
+	data, marshalErr := json.Marshal(err)
+	assert.NoError(t, marshalErr)
+	assert.JSONEq(t, `{
+		"kind": "UNIQUE_VIOLATION",
+		"dialect": "POSTGRES",
+		"code": "23505",
+		"error": "pq: duplicate key value violates unique constraint",
+		"table": "users",
+		"column": "email",
+		"constraint": "users_email_key",
+		"schema": "public",
+		"diagnostics": {"severity": "ERROR", "routine": "_bt_check_unique"}
+	}`, string(data))
+
+	decoded, unmarshalErr := dberrors.UnmarshalError(data)
+	assert.NoError(t, unmarshalErr)
+
+	var uniqueViolationError *dberrors.UniqueViolationError
+	if assert.True(t, errors.As(decoded, &uniqueViolationError)) {
+		assert.Equal(t, "users", uniqueViolationError.Table)
+		assert.Equal(t, "email", uniqueViolationError.Column)
+		assert.Equal(t, "users_email_key", uniqueViolationError.Constraint)
+		assert.Equal(t, "public", uniqueViolationError.Schema)
+		assert.Equal(t, dberrors.POSTGRES, uniqueViolationError.Dialect())
+		assert.Equal(t, err.Diagnostics(), uniqueViolationError.Diagnostics())
+		assert.EqualError(t, errors.Unwrap(uniqueViolationError), "pq: duplicate key value violates unique constraint")
+	}
+}
+
+func TestUnmarshalError(t *testing.T) {
+	native := errors.New("native")
+
+	for _, err := range []error{
+		&dberrors.DataError{Reason: dberrors.NumericOutOfRange, Column: "age", Row: 2, DbError: dberrors.NewDbError(native, dberrors.MYSQL)},
+		&dberrors.ForeignKeyViolationError{Table: "orders", Constraint: "orders_user_id_fkey", DbError: dberrors.NewDbError(native, dberrors.SQLITE3)},
+		&dberrors.ConnectionError{MaybeExecuted: true, DbError: dberrors.NewDbError(native, dberrors.MSSQL)},
+		&dberrors.TimeoutError{Source: dberrors.ClientSource, DbError: dberrors.NewDbError(native, dberrors.POSTGRES)},
+		&dberrors.SyntaxError{Token: "form", Line: 1, Position: 10, DbError: dberrors.NewDbError(native, dberrors.POSTGRES)},
+		&dberrors.ApplicationError{Code: "P0001", DbError: dberrors.NewDbErrorWithDiagnostics(native, dberrors.POSTGRES, dberrors.Diagnostics{Code: "P0001"})},
+		&dberrors.ReadOnlyError{DbError: dberrors.NewDbError(native, dberrors.MYSQL)},
+	} {
+		t.Run(string(dberrors.KindOf(err)), func(t *testing.T) {
+			data, marshalErr := json.Marshal(err)
+			assert.NoError(t, marshalErr)
+
+			decoded, unmarshalErr := dberrors.UnmarshalError(data)
+			assert.NoError(t, unmarshalErr)
+			assert.Equal(t, err, decoded)
+		})
+	}
+}
+
+func TestUnmarshalErrorInvalid(t *testing.T) {
+	_, err := dberrors.UnmarshalError([]byte(`{"kind":"UNKNOWN"}`))
+	assert.EqualError(t, err, `dberrors: unknown error kind "UNKNOWN"`)
+
+	var deadlockError dberrors.DeadlockError
+	assert.EqualError(t, json.Unmarshal([]byte(`{"kind":"LOCK_TIMEOUT"}`), &deadlockError), "dberrors: cannot unmarshal LOCK_TIMEOUT error into DEADLOCK error")
+}
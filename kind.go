@@ -0,0 +1,156 @@
+package dberrors
+
+import "errors"
+
+// Kind Kind export
+// Identifies the category of a standardized error, e.g. for logs, metrics and API responses.
+type Kind string
+
+const (
+	// KindDataError KindDataError
+	KindDataError Kind = "DATA_ERROR"
+	// KindCheckViolation KindCheckViolation
+	KindCheckViolation Kind = "CHECK_VIOLATION"
+	// KindForeignKeyViolation KindForeignKeyViolation
+	KindForeignKeyViolation Kind = "FOREIGN_KEY_VIOLATION"
+	// KindNotNullViolation KindNotNullViolation
+	KindNotNullViolation Kind = "NOT_NULL_VIOLATION"
+	// KindUniqueViolation KindUniqueViolation
+	KindUniqueViolation Kind = "UNIQUE_VIOLATION"
+	// KindDeadlock KindDeadlock
+	KindDeadlock Kind = "DEADLOCK"
+	// KindSerializationFailure KindSerializationFailure
+	KindSerializationFailure Kind = "SERIALIZATION_FAILURE"
+	// KindLockTimeout KindLockTimeout
+	KindLockTimeout Kind = "LOCK_TIMEOUT"
+	// KindConnection KindConnection
+	KindConnection Kind = "CONNECTION_ERROR"
+	// KindTimeout KindTimeout
+	KindTimeout Kind = "TIMEOUT"
+	// KindCanceled KindCanceled
+	KindCanceled Kind = "CANCELED"
+	// KindPermissionDenied KindPermissionDenied
+	KindPermissionDenied Kind = "PERMISSION_DENIED"
+	// KindAuthentication KindAuthentication
+	KindAuthentication Kind = "AUTHENTICATION_ERROR"
+	// KindUndefinedObject KindUndefinedObject
+	KindUndefinedObject Kind = "UNDEFINED_OBJECT"
+	// KindSyntax KindSyntax
+	KindSyntax Kind = "SYNTAX_ERROR"
+	// KindResourceExhausted KindResourceExhausted
+	KindResourceExhausted Kind = "RESOURCE_EXHAUSTED"
+	// KindReadOnly KindReadOnly
+	KindReadOnly Kind = "READ_ONLY"
+	// KindAbortedTransaction KindAbortedTransaction
+	KindAbortedTransaction Kind = "ABORTED_TRANSACTION"
+	// KindApplication KindApplication
+	KindApplication Kind = "APPLICATION_ERROR"
+)
+
+// KindOf KindOf export
+// Returns the Kind of the first standardized error in err's chain and "" when there is none.
+func KindOf(err error) Kind {
+	var kinded interface {
+		Kind() Kind
+	}
+	if errors.As(err, &kinded) {
+		return kinded.Kind()
+	}
+
+	return ""
+}
+
+// Kind Kind implementation
+func (e *DataError) Kind() Kind {
+	return KindDataError
+}
+
+// Kind Kind implementation
+func (e *CheckViolationError) Kind() Kind {
+	return KindCheckViolation
+}
+
+// Kind Kind implementation
+func (e *ForeignKeyViolationError) Kind() Kind {
+	return KindForeignKeyViolation
+}
+
+// Kind Kind implementation
+func (e *NotNullViolationError) Kind() Kind {
+	return KindNotNullViolation
+}
+
+// Kind Kind implementation
+func (e *UniqueViolationError) Kind() Kind {
+	return KindUniqueViolation
+}
+
+// Kind Kind implementation
+func (e *DeadlockError) Kind() Kind {
+	return KindDeadlock
+}
+
+// Kind Kind implementation
+func (e *SerializationFailureError) Kind() Kind {
+	return KindSerializationFailure
+}
+
+// Kind Kind implementation
+func (e *LockTimeoutError) Kind() Kind {
+	return KindLockTimeout
+}
+
+// Kind Kind implementation
+func (e *ConnectionError) Kind() Kind {
+	return KindConnection
+}
+
+// Kind Kind implementation
+func (e *TimeoutError) Kind() Kind {
+	return KindTimeout
+}
+
+// Kind Kind implementation
+func (e *CanceledError) Kind() Kind {
+	return KindCanceled
+}
+
+// Kind Kind implementation
+func (e *PermissionDeniedError) Kind() Kind {
+	return KindPermissionDenied
+}
+
+// Kind Kind implementation
+func (e *AuthenticationError) Kind() Kind {
+	return KindAuthentication
+}
+
+// Kind Kind implementation
+func (e *UndefinedObjectError) Kind() Kind {
+	return KindUndefinedObject
+}
+
+// Kind Kind implementation
+func (e *SyntaxError) Kind() Kind {
+	return KindSyntax
+}
+
+// Kind Kind implementation
+func (e *ResourceExhaustedError) Kind() Kind {
+	return KindResourceExhausted
+}
+
+// Kind Kind implementation
+func (e *ReadOnlyError) Kind() Kind {
+	return KindReadOnly
+}
+
+// Kind Kind implementation
+func (e *AbortedTransactionError) Kind() Kind {
+	return KindAbortedTransaction
+}
+
+// Kind Kind implementation
+func (e *ApplicationError) Kind() Kind {
+	return KindApplication
+}
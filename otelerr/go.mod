@@ -0,0 +1,16 @@
+module github.com/stackworx-go/dberrors/otelerr
+
+go 1.15
+
+require (
+	github.com/go-sql-driver/mysql v1.5.0
+	github.com/mattn/go-sqlite3 v1.14.12
+	github.com/stackworx-go/dberrors v0.0.0
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v1.0.1
+	go.opentelemetry.io/otel/sdk v1.0.1
+	go.opentelemetry.io/otel/trace v1.0.1
+)
+
+// Builds against the core package of this repository, bump the requirement to a tagged release when releasing
+replace github.com/stackworx-go/dberrors => ../
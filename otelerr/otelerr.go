@@ -0,0 +1,212 @@
+// Package otelerr records standardized database errors on OpenTelemetry spans.
+package otelerr
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+
+	"github.com/stackworx-go/dberrors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Options Options export
+type Options struct {
+	// Reports whether err is anticipated by the caller, e.g. a unique violation in get-or-create.
+	// Expected errors are recorded without setting the span status to Error, see also Expect.
+	Expected func(err error) bool
+}
+
+var systems = map[dberrors.Dialect]string{
+	dberrors.POSTGRES: "postgresql",
+	dberrors.MYSQL:    "mysql",
+	dberrors.MSSQL:    "mssql",
+	dberrors.SQLITE3:  "sqlite",
+}
+
+type expectedKey struct{}
+
+// Expect Expect export
+// Declares errors of kinds as expected for calls made with the returned context, e.g.
+//
+//	_, err := db.ExecContext(otelerr.Expect(ctx, dberrors.KindUniqueViolation), query, args...)
+func Expect(ctx context.Context, kinds ...dberrors.Kind) context.Context {
+	expected, _ := ctx.Value(expectedKey{}).([]dberrors.Kind)
+
+	return context.WithValue(ctx, expectedKey{}, append(append([]dberrors.Kind{}, expected...), kinds...))
+}
+
+// Attributes Attributes export
+// Returns the span attributes of err, which is classified with dberrors.Parse.
+// Returns nil when err is not a database error.
+func Attributes(err error) []attribute.KeyValue {
+	return attributes(dberrors.Parse(err))
+}
+
+func attributes(parsedErr error) []attribute.KeyValue {
+	kind := dberrors.KindOf(parsedErr)
+	if kind == "" {
+		return nil
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("error.type", string(kind)),
+	}
+
+	var dbError interface {
+		Dialect() dberrors.Dialect
+		Diagnostics() dberrors.Diagnostics
+	}
+	if errors.As(parsedErr, &dbError) {
+		if system, ok := systems[dbError.Dialect()]; ok {
+			attrs = append(attrs, attribute.String("db.system", system))
+		}
+
+		// The SQLSTATE for postgres, the native error number for the other dialects
+		if code := dbError.Diagnostics().Code; code != "" {
+			attrs = append(attrs, attribute.String("db.response.status_code", code))
+		}
+	}
+
+	schema, table, column, constraint := identifiers(parsedErr)
+
+	if schema != "" {
+		attrs = append(attrs, attribute.String("dberrors.schema", schema))
+	}
+
+	if table != "" {
+		attrs = append(attrs, attribute.String("db.collection.name", table))
+	}
+
+	if column != "" {
+		// Postgres reports all columns of a composite key, e.g. "a, b"
+		var columns []string
+		for _, c := range strings.Split(column, ",") {
+			columns = append(columns, strings.TrimSpace(c))
+		}
+		attrs = append(attrs, attribute.StringSlice("dberrors.columns", columns))
+	}
+
+	if constraint != "" {
+		attrs = append(attrs, attribute.String("dberrors.constraint", constraint))
+	}
+
+	return attrs
+}
+
+// RecordError RecordError export
+// Records err on the span of ctx: the attributes of err are set on the span and an exception event is added.
+// The span status is set to Error unless the error is expected, see Options.Expected and Expect.
+// Reports whether err is a database error, other errors are not recorded.
+func RecordError(ctx context.Context, err error, opts *Options) bool {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	parsedErr := dberrors.Parse(err)
+
+	attrs := attributes(parsedErr)
+	if attrs == nil {
+		return false
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return true
+	}
+
+	// Recorded without the wrapping errors so that the exception type is the type of the standardized error
+	var dbError interface {
+		error
+		Kind() dberrors.Kind
+	}
+	errors.As(parsedErr, &dbError)
+
+	span.SetAttributes(attrs...)
+	span.RecordError(dbError)
+
+	if !isExpected(ctx, err, dbError.Kind(), opts) {
+		span.SetStatus(codes.Error, dbError.Error())
+	}
+
+	return true
+}
+
+func isExpected(ctx context.Context, err error, kind dberrors.Kind, opts *Options) bool {
+	if opts.Expected != nil && opts.Expected(err) {
+		return true
+	}
+
+	expected, _ := ctx.Value(expectedKey{}).([]dberrors.Kind)
+	for _, k := range expected {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Hook Hook export
+// Records the errors returned by a database/sql driver on the span of the query context.
+// It implements the Hooks and OnErrorer interfaces of github.com/qustavo/sqlhooks:
+//
+//	sql.Register("postgres-otel", sqlhooks.Wrap(&pq.Driver{}, otelerr.NewHook(nil)))
+type Hook struct {
+	opts *Options
+}
+
+// NewHook NewHook export
+func NewHook(opts *Options) *Hook {
+	return &Hook{opts: opts}
+}
+
+// Before Before implementation
+func (h *Hook) Before(ctx context.Context, query string, args ...interface{}) (context.Context, error) {
+	return ctx, nil
+}
+
+// After After implementation
+func (h *Hook) After(ctx context.Context, query string, args ...interface{}) (context.Context, error) {
+	return ctx, nil
+}
+
+// OnError OnError implementation
+// err is returned unchanged
+func (h *Hook) OnError(ctx context.Context, err error, query string, args ...interface{}) error {
+	// Not an error, database/sql falls back to another code path
+	// or retries the query on a new connection
+	if err == driver.ErrSkip || err == driver.ErrBadConn {
+		return err
+	}
+
+	RecordError(ctx, err, h.opts)
+
+	return err
+}
+
+func identifiers(err error) (string, string, string, string) {
+	var uniqueViolationError *dberrors.UniqueViolationError
+	var foreignKeyViolationError *dberrors.ForeignKeyViolationError
+	var checkViolationError *dberrors.CheckViolationError
+	var notNullViolationError *dberrors.NotNullViolationError
+	var dataError *dberrors.DataError
+
+	switch {
+	case errors.As(err, &uniqueViolationError):
+		return uniqueViolationError.Schema, uniqueViolationError.Table, uniqueViolationError.Column, uniqueViolationError.Constraint
+	case errors.As(err, &foreignKeyViolationError):
+		return foreignKeyViolationError.Schema, foreignKeyViolationError.Table, "", foreignKeyViolationError.Constraint
+	case errors.As(err, &checkViolationError):
+		return "", checkViolationError.Table, "", checkViolationError.Constraint
+	case errors.As(err, &notNullViolationError):
+		return notNullViolationError.Schema, notNullViolationError.Table, notNullViolationError.Column, ""
+	case errors.As(err, &dataError):
+		return dataError.Schema, dataError.Table, dataError.Column, ""
+	}
+
+	return "", "", "", ""
+}
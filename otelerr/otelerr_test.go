@@ -0,0 +1,214 @@
+package otelerr_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/otelerr"
+	_ "github.com/stackworx-go/dberrors/parser/mysql"
+	_ "github.com/stackworx-go/dberrors/parser/sqlite"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func record(t *testing.T, ctx context.Context, fn func(ctx context.Context)) tracetest.SpanStub {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	ctx, span := provider.Tracer("otelerr").Start(ctx, "query")
+	fn(ctx)
+	span.End()
+
+	spans := exporter.GetSpans()
+	if !assert.Len(t, spans, 1) {
+		t.FailNow()
+	}
+
+	return spans[0]
+}
+
+var uniqueViolation = &dberrors.UniqueViolationError{
+	Schema:     "public",
+	Table:      "users",
+	Column:     "first_name, last_name",
+	Constraint: "users_name_key",
+	DbError:    dberrors.NewDbErrorWithDiagnostics(errors.New("pq: duplicate key value"), dberrors.POSTGRES, dberrors.Diagnostics{Code: "23505"}),
+}
+
+func TestRecordError(t *testing.T) {
+	span := record(t, context.Background(), func(ctx context.Context) {
+		assert.True(t, otelerr.RecordError(ctx, fmt.Errorf("create user: %w", uniqueViolation), nil))
+	})
+
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("error.type", "UNIQUE_VIOLATION"),
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.response.status_code", "23505"),
+		attribute.String("dberrors.schema", "public"),
+		attribute.String("db.collection.name", "users"),
+		attribute.StringSlice("dberrors.columns", []string{"first_name", "last_name"}),
+		attribute.String("dberrors.constraint", "users_name_key"),
+	}, span.Attributes)
+
+	assert.Equal(t, codes.Error, span.Status.Code)
+	assert.Equal(t, "unique violation error users.users_name_key", span.Status.Description)
+
+	if assert.Len(t, span.Events, 1) {
+		assert.Equal(t, "exception", span.Events[0].Name)
+		assert.ElementsMatch(t, []attribute.KeyValue{
+			attribute.String("exception.type", "*dberrors.UniqueViolationError"),
+			attribute.String("exception.message", "unique violation error users.users_name_key"),
+		}, span.Events[0].Attributes)
+	}
+}
+
+func TestRecordErrorExpected(t *testing.T) {
+	span := record(t, context.Background(), func(ctx context.Context) {
+		ctx = otelerr.Expect(ctx, dberrors.KindUniqueViolation)
+		assert.True(t, otelerr.RecordError(ctx, uniqueViolation, nil))
+	})
+
+	assert.Equal(t, codes.Unset, span.Status.Code)
+	assert.Contains(t, span.Attributes, attribute.String("error.type", "UNIQUE_VIOLATION"))
+	assert.Len(t, span.Events, 1)
+
+	span = record(t, context.Background(), func(ctx context.Context) {
+		ctx = otelerr.Expect(ctx, dberrors.KindForeignKeyViolation)
+		assert.True(t, otelerr.RecordError(ctx, uniqueViolation, nil))
+	})
+
+	assert.Equal(t, codes.Error, span.Status.Code)
+
+	span = record(t, context.Background(), func(ctx context.Context) {
+		assert.True(t, otelerr.RecordError(ctx, uniqueViolation, &otelerr.Options{
+			Expected: func(err error) bool {
+				return dberrors.IgnoreUnique(err, "users_name_key") == nil
+			},
+		}))
+	})
+
+	assert.Equal(t, codes.Unset, span.Status.Code)
+}
+
+func TestRecordErrorNotDatabaseError(t *testing.T) {
+	span := record(t, context.Background(), func(ctx context.Context) {
+		assert.False(t, otelerr.RecordError(ctx, errors.New("boom"), nil))
+	})
+
+	assert.Empty(t, span.Attributes)
+	assert.Empty(t, span.Events)
+	assert.Equal(t, codes.Unset, span.Status.Code)
+}
+
+func TestHook(t *testing.T) {
+	hook := otelerr.NewHook(nil)
+	native := &mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock; try restarting transaction"}
+
+	span := record(t, context.Background(), func(ctx context.Context) {
+		ctx, err := hook.Before(ctx, "UPDATE accounts SET balance = ?", 10)
+		assert.NoError(t, err)
+
+		assert.Equal(t, driver.ErrSkip, hook.OnError(ctx, driver.ErrSkip, "UPDATE accounts SET balance = ?", 10))
+		assert.Equal(t, driver.ErrBadConn, hook.OnError(ctx, driver.ErrBadConn, "UPDATE accounts SET balance = ?", 10))
+		assert.Equal(t, native, hook.OnError(ctx, native, "UPDATE accounts SET balance = ?", 10))
+	})
+
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("error.type", "DEADLOCK"),
+		attribute.String("db.system", "mysql"),
+		attribute.String("db.response.status_code", "1213"),
+	}, span.Attributes)
+	assert.Equal(t, codes.Error, span.Status.Code)
+	assert.Len(t, span.Events, 1)
+}
+
+// hookedDriver calls the hook around queries the same way as sqlhooks.Wrap
+type hookedDriver struct {
+	driver.Driver
+	hook *otelerr.Hook
+}
+
+func (d *hookedDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hookedConn{Conn: conn, hook: d.hook}, nil
+}
+
+type hookedConn struct {
+	driver.Conn
+	hook *otelerr.Hook
+}
+
+func (c *hookedConn) ExecContext(ctx context.Context, query string, namedArgs []driver.NamedValue) (driver.Result, error) {
+	args := make([]interface{}, len(namedArgs))
+	for i, arg := range namedArgs {
+		args[i] = arg.Value
+	}
+
+	ctx, err := c.hook.Before(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.Conn.(driver.ExecerContext).ExecContext(ctx, query, namedArgs)
+	if err != nil {
+		return nil, c.hook.OnError(ctx, err, query, args...)
+	}
+
+	if _, err := c.hook.After(ctx, query, args...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func init() {
+	sql.Register("sqlite3-otel", &hookedDriver{Driver: &sqlite3.SQLiteDriver{}, hook: otelerr.NewHook(nil)})
+}
+
+func TestHookDriver(t *testing.T) {
+	db, err := sql.Open("sqlite3-otel", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// Every connection opens its own in-memory database
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec("create table users (email text unique)"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec("insert into users (email) values ('jane@example.com')"); err != nil {
+		t.Fatal(err)
+	}
+
+	span := record(t, context.Background(), func(ctx context.Context) {
+		_, err := db.ExecContext(ctx, "insert into users (email) values ('jane@example.com')")
+		assert.Error(t, err)
+	})
+
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("error.type", "UNIQUE_VIOLATION"),
+		attribute.String("db.system", "sqlite"),
+		attribute.String("db.response.status_code", "2067"),
+		attribute.String("db.collection.name", "users"),
+		attribute.StringSlice("dberrors.columns", []string{"email"}),
+	}, span.Attributes)
+	assert.Equal(t, codes.Error, span.Status.Code)
+	assert.Len(t, span.Events, 1)
+}
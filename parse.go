@@ -0,0 +1,90 @@
+package dberrors
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+var (
+	parsersMu sync.RWMutex
+	parsers   = make(map[Dialect]func(error) error)
+)
+
+// RegisterParser RegisterParser export
+// Makes a dialect parser available to Parse. The parser packages register themselves when imported.
+func RegisterParser(d Dialect, parse func(error) error) {
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+
+	parsers[d] = parse
+}
+
+// standardized is implemented by all errors embedding DbError
+type standardized interface {
+	dbError() *DbError
+}
+
+func (e *DbError) dbError() *DbError {
+	return e
+}
+
+// Parse Parse export
+// Transforms err using the registered dialect parsers.
+// Errors which are not specific to a dialect, such as context errors, driver.ErrBadConn or network errors,
+// are classified without a dialect.
+// Returns err as is when it already is a standardized error and nil when it is not recognised.
+func Parse(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var s standardized
+	if errors.As(err, &s) {
+		return err
+	}
+
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+
+	dialects := make([]string, 0, len(parsers))
+	for d := range parsers {
+		dialects = append(dialects, string(d))
+	}
+	// Make the result deterministic for errors which are not dialect specific
+	sort.Strings(dialects)
+
+	for _, d := range dialects {
+		if parsedErr := parsers[Dialect(d)](err); parsedErr != nil {
+			return parsedErr
+		}
+	}
+
+	return parseGeneric(err, "")
+}
+
+// ParseDialect ParseDialect export
+// Transforms err using the parser registered for d, errors which are not specific to a dialect are classified with d.
+// Returns err as is when it already is a standardized error and nil when it is not recognised.
+func ParseDialect(err error, d Dialect) error {
+	if err == nil {
+		return nil
+	}
+
+	var s standardized
+	if errors.As(err, &s) {
+		return err
+	}
+
+	parsersMu.RLock()
+	parse, ok := parsers[d]
+	parsersMu.RUnlock()
+
+	if ok {
+		if parsedErr := parse(err); parsedErr != nil {
+			return parsedErr
+		}
+	}
+
+	return parseGeneric(err, d)
+}
@@ -0,0 +1,71 @@
+package dberrors_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/parser/mssql"
+	"github.com/stackworx-go/dberrors/parser/mysql"
+	"github.com/stackworx-go/dberrors/parser/postgres"
+	"github.com/stackworx-go/dberrors/parser/sqlite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConnectionError(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		err           error
+		maybeExecuted bool
+	}{
+		{"bad connection", driver.ErrBadConn, true},
+		{"dial", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, false},
+		{"read", &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}, true},
+		{"eof", io.EOF, true},
+		{"wrapped unexpected eof", fmt.Errorf("reading response: %w", io.ErrUnexpectedEOF), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Generic errors are not claimed by any of the registered dialect parsers
+			assert.Equal(t, &dberrors.ConnectionError{
+				MaybeExecuted: tc.maybeExecuted,
+				DbError:       dberrors.NewDbError(tc.err, ""),
+			}, dberrors.Parse(tc.err))
+
+			assert.Equal(t, &dberrors.ConnectionError{
+				MaybeExecuted: tc.maybeExecuted,
+				DbError:       dberrors.NewDbError(tc.err, dberrors.POSTGRES),
+			}, dberrors.ParseDialect(tc.err, dberrors.POSTGRES))
+		})
+	}
+}
+
+func TestParseContextError(t *testing.T) {
+	err := fmt.Errorf("query: %w", context.DeadlineExceeded)
+	assert.Equal(t, &dberrors.TimeoutError{
+		Source:  dberrors.ClientSource,
+		DbError: dberrors.NewDbError(err, ""),
+	}, dberrors.Parse(err))
+
+	assert.Equal(t, &dberrors.CanceledError{
+		Source:  dberrors.ClientSource,
+		DbError: dberrors.NewDbError(context.Canceled, dberrors.MYSQL),
+	}, dberrors.ParseDialect(context.Canceled, dberrors.MYSQL))
+}
+
+func TestParseIgnoresOtherErrors(t *testing.T) {
+	assert.Nil(t, dberrors.Parse(errors.New("other")))
+	assert.Nil(t, dberrors.ParseDialect(errors.New("other"), dberrors.SQLITE3))
+}
+
+func TestDialectParsersIgnoreGenericErrors(t *testing.T) {
+	for _, parse := range []func(error) error{mssql.Parse, mysql.Parse, postgres.Parse, sqlite.Parse} {
+		assert.Nil(t, parse(driver.ErrBadConn))
+		assert.Nil(t, parse(io.EOF))
+		assert.Nil(t, parse(context.Canceled))
+	}
+}
@@ -5,11 +5,20 @@ import (
 	"github.com/stackworx-go/dberrors"
 	"github.com/stackworx-go/dberrors/internal/dialect"
 	"regexp"
+	"strconv"
 )
 
+func init() {
+	dberrors.RegisterParser(dialect.MSSQL, Parse)
+}
+
 // Parse Parse export
 func Parse(err error) error {
 	if nativeError, ok := err.(mssqldb.Error); ok {
+		if err := applicationError(nativeError); err != nil {
+			return err
+		}
+
 		if err := uniqueViolationError(nativeError); err != nil {
 			return err
 		}
@@ -26,10 +35,52 @@ func Parse(err error) error {
 			return err
 		}
 
-		if isDataException(nativeError) {
-			return &dberrors.DataError{
-				DbError: dberrors.NewDbError(nativeError, dialect.MSSQL),
-			}
+		if err := dataError(nativeError); err != nil {
+			return err
+		}
+
+		if err := concurrencyError(nativeError); err != nil {
+			return err
+		}
+
+		if err := connectionError(nativeError); err != nil {
+			return err
+		}
+
+		if err := timeoutError(nativeError); err != nil {
+			return err
+		}
+
+		if err := permissionError(nativeError); err != nil {
+			return err
+		}
+
+		if err := undefinedObjectError(nativeError); err != nil {
+			return err
+		}
+
+		if err := syntaxError(nativeError); err != nil {
+			return err
+		}
+
+		if err := resourceExhaustedError(nativeError); err != nil {
+			return err
+		}
+
+		if err := readOnlyError(nativeError); err != nil {
+			return err
+		}
+
+		if err := abortedTransactionError(nativeError); err != nil {
+			return err
+		}
+	}
+
+	// Returned by the driver when the TDS stream is corrupted or cut off
+	if streamError, ok := err.(mssqldb.StreamError); ok {
+		return &dberrors.ConnectionError{
+			MaybeExecuted: true,
+			DbError:       dberrors.NewDbError(streamError, dialect.MSSQL),
 		}
 	}
 
@@ -47,7 +98,7 @@ func uniqueViolationError(nativeError mssqldb.Error) error {
 				Table:      match[3],
 				Schema:     match[2],
 				Constraint: match[1],
-				DbError:    dberrors.NewDbError(nativeError, dialect.MSSQL),
+				DbError:    newDbError(nativeError),
 			}
 		}
 	}
@@ -59,7 +110,7 @@ func uniqueViolationError(nativeError mssqldb.Error) error {
 				Table:      match[2],
 				Constraint: match[3],
 				Schema:     match[1],
-				DbError:    dberrors.NewDbError(nativeError, dialect.MSSQL),
+				DbError:    newDbError(nativeError),
 			}
 		}
 	}
@@ -70,13 +121,13 @@ func uniqueViolationError(nativeError mssqldb.Error) error {
 var notNullViolationErrorRe = regexp.MustCompile(`Cannot insert the value NULL into column '(.+)', table '(.+)\.(.+)\.(.+)'; column does not allow nulls. (?:INSERT|UPDATE) fails.`)
 
 func notNullViolationError(nativeError mssqldb.Error) error {
-	if isErrorClassAndNumber(nativeError, 16, 515) || isErrorClassAndNumber(nativeError, 16, 50000) {
+	if isErrorClassAndNumber(nativeError, 16, 515) {
 		if match := notNullViolationErrorRe.FindStringSubmatch(nativeError.Message); match != nil {
 			return &dberrors.NotNullViolationError{
 				Table:   match[4],
 				Column:  match[1],
 				Schema:  match[3],
-				DbError: dberrors.NewDbError(nativeError, dialect.MSSQL),
+				DbError: newDbError(nativeError),
 			}
 		}
 
@@ -95,7 +146,7 @@ func foreignKeyViolationError(nativeError mssqldb.Error) error {
 				Table:      match[4],
 				Schema:     match[3],
 				Constraint: match[1],
-				DbError:    dberrors.NewDbError(nativeError, dialect.MSSQL),
+				DbError:    newDbError(nativeError),
 			}
 		}
 
@@ -104,7 +155,7 @@ func foreignKeyViolationError(nativeError mssqldb.Error) error {
 				Table:      match[4],
 				Schema:     match[3],
 				Constraint: match[1],
-				DbError:    dberrors.NewDbError(nativeError, dialect.MSSQL),
+				DbError:    newDbError(nativeError),
 			}
 		}
 	}
@@ -120,7 +171,7 @@ func checkViolationError(nativeError mssqldb.Error) error {
 			return &dberrors.CheckViolationError{
 				Table:      match[5],
 				Constraint: match[2],
-				DbError:    dberrors.NewDbError(nativeError, dialect.MSSQL),
+				DbError:    newDbError(nativeError),
 			}
 		}
 	}
@@ -128,17 +179,319 @@ func checkViolationError(nativeError mssqldb.Error) error {
 	return nil
 }
 
+func concurrencyError(nativeError mssqldb.Error) error {
+	// 1205 - Transaction was deadlocked on lock resources with another process and has been chosen as the deadlock victim.
+	if isErrorClassAndNumber(nativeError, 13, 1205) {
+		return &dberrors.DeadlockError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	// 3960 - Snapshot isolation transaction aborted due to update conflict.
+	if isErrorClassAndNumber(nativeError, 16, 3960) {
+		return &dberrors.SerializationFailureError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	// 1222 - Lock request time out period exceeded.
+	if isErrorClassAndNumber(nativeError, 16, 1222) {
+		return &dberrors.LockTimeoutError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func connectionError(nativeError mssqldb.Error) error {
+	// 6005 - SHUTDOWN is in progress.
+	if isErrorClassAndNumber(nativeError, 14, 6005) {
+		return &dberrors.ConnectionError{
+			MaybeExecuted: false,
+			DbError:       newDbError(nativeError),
+		}
+	}
+
+	// 596 - Cannot continue the execution because the session is in the kill state.
+	if isErrorClassAndNumber(nativeError, 21, 596) {
+		return &dberrors.ConnectionError{
+			MaybeExecuted: true,
+			DbError:       newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func timeoutError(nativeError mssqldb.Error) error {
+	// 3980 - The request failed to run because the batch is aborted, this can be caused by abort signal sent from client, or another request is running in the same session, which makes the session busy.
+	if isErrorClassAndNumber(nativeError, 16, 3980) {
+		return &dberrors.CanceledError{
+			Source:  dberrors.ClientSource,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+var permissionErrorObjectRe = regexp.MustCompile(`The (\w+) permission was denied on the object '(.+)', database '(.+)', schema '(.+)'.`)
+var permissionErrorColumnRe = regexp.MustCompile(`The (\w+) permission was denied on the column '(.+)' of the object '(.+)', database '(.+)', schema '(.+)'.`)
+var authenticationErrorRe = regexp.MustCompile(`Login failed for user '(.*)'`)
+
+func permissionError(nativeError mssqldb.Error) error {
+	// 229 - The %ls permission was denied on the object '%.*ls', database '%.*ls', schema '%.*ls'.
+	if isErrorClassAndNumber(nativeError, 14, 229) {
+		if match := permissionErrorObjectRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.PermissionDeniedError{
+				Privilege:  match[1],
+				ObjectType: "object",
+				Object:     match[2],
+				Schema:     match[4],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	// 230 - The %ls permission was denied on the column '%.*ls' of the object '%.*ls', database '%.*ls', schema '%.*ls'.
+	if isErrorClassAndNumber(nativeError, 14, 230) {
+		if match := permissionErrorColumnRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.PermissionDeniedError{
+				Privilege:  match[1],
+				ObjectType: "column",
+				Object:     match[3] + "." + match[2],
+				Schema:     match[5],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	// 18456 - Login failed for user '%.*ls'.%.*ls
+	if isErrorClassAndNumber(nativeError, 14, 18456) {
+		authenticationError := &dberrors.AuthenticationError{
+			DbError: newDbError(nativeError),
+		}
+
+		if match := authenticationErrorRe.FindStringSubmatch(nativeError.Message); match != nil {
+			authenticationError.User = match[1]
+		}
+
+		return authenticationError
+	}
+
+	return nil
+}
+
+var undefinedObjectErrorObjectRe = regexp.MustCompile(`Invalid object name '(?:(.+)\.)?(.+)'.`)
+var undefinedObjectErrorColumnRe = regexp.MustCompile(`Invalid column name '(.+)'.`)
+var undefinedObjectErrorProcedureRe = regexp.MustCompile(`Could not find stored procedure '(?:(.+)\.)?(.+)'.`)
+
+func undefinedObjectError(nativeError mssqldb.Error) error {
+	// 208 - Invalid object name '%.*ls'.
+	if isErrorClassAndNumber(nativeError, 16, 208) {
+		if match := undefinedObjectErrorObjectRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.UndefinedObjectError{
+				ObjectType: "table",
+				Name:       match[2],
+				Schema:     match[1],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	// 207 - Invalid column name '%.*ls'.
+	if isErrorClassAndNumber(nativeError, 16, 207) {
+		if match := undefinedObjectErrorColumnRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.UndefinedObjectError{
+				ObjectType: "column",
+				Name:       match[1],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	// 2812 - Could not find stored procedure '%.*ls'.
+	if isErrorClassAndNumber(nativeError, 16, 2812) {
+		if match := undefinedObjectErrorProcedureRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.UndefinedObjectError{
+				ObjectType: "procedure",
+				Name:       match[2],
+				Schema:     match[1],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	return nil
+}
+
+var syntaxErrorRe = regexp.MustCompile(`Incorrect syntax near (?:the keyword )?'(.*)'`)
+
+func syntaxError(nativeError mssqldb.Error) error {
+	// 102 - Incorrect syntax near '%.*ls'.
+	// 156 - Incorrect syntax near the keyword '%.*ls'.
+	if isErrorClassAndNumber(nativeError, 15, 102) || isErrorClassAndNumber(nativeError, 15, 156) {
+		syntaxError := &dberrors.SyntaxError{
+			Line:    int(nativeError.LineNo),
+			DbError: newDbError(nativeError),
+		}
+
+		if match := syntaxErrorRe.FindStringSubmatch(nativeError.Message); match != nil {
+			syntaxError.Token = match[1]
+		}
+
+		return syntaxError
+	}
+
+	return nil
+}
+
+func resourceExhaustedError(nativeError mssqldb.Error) error {
+	// 1105 - Could not allocate space for object '%.*ls'%.*ls in database '%.*ls' because the '%.*ls' filegroup is full.
+	// 9002 - The transaction log for database '%.*ls' is full due to '%ls'.
+	if isErrorClassAndNumber(nativeError, 17, 1105) || isErrorClassAndNumber(nativeError, 17, 9002) {
+		return &dberrors.ResourceExhaustedError{
+			Resource: "disk",
+			DbError:  newDbError(nativeError),
+		}
+	}
+
+	// 701 - There is insufficient system memory in resource pool '%ls' to run this query.
+	if isErrorClassAndNumber(nativeError, 17, 701) {
+		return &dberrors.ResourceExhaustedError{
+			Resource: "memory",
+			DbError:  newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func readOnlyError(nativeError mssqldb.Error) error {
+	// 3906 - Failed to update database "%.*ls" because the database is read-only.
+	if isErrorClassAndNumber(nativeError, 16, 3906) {
+		return &dberrors.ReadOnlyError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func abortedTransactionError(nativeError mssqldb.Error) error {
+	// 3930 - The current transaction cannot be committed and cannot support operations that write to the log file. Roll back the transaction.
+	if isErrorClassAndNumber(nativeError, 16, 3930) {
+		return &dberrors.AbortedTransactionError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func applicationError(nativeError mssqldb.Error) error {
+	// User defined messages are numbered from 50000, RAISERROR with a message string raises 50000
+	if nativeError.SQLErrorNumber() >= 50000 {
+		return &dberrors.ApplicationError{
+			Code:    strconv.Itoa(int(nativeError.SQLErrorNumber())),
+			Message: nativeError.Message,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+// Diagnostics Diagnostics export
+func Diagnostics(err error) dberrors.Diagnostics {
+	nativeError, ok := err.(mssqldb.Error)
+	if !ok {
+		return dberrors.Diagnostics{}
+	}
+
+	return dberrors.Diagnostics{
+		Code:          strconv.Itoa(int(nativeError.Number)),
+		Procedure:     nativeError.ProcName,
+		ProcedureLine: int(nativeError.LineNo),
+		Server:        nativeError.ServerName,
+		State:         nativeError.State,
+		Class:         nativeError.Class,
+	}
+}
+
+func newDbError(nativeError mssqldb.Error) dberrors.DbError {
+	return dberrors.NewDbErrorWithDiagnostics(nativeError, dialect.MSSQL, Diagnostics(nativeError))
+}
+
 func isErrorClassAndNumber(nativeError mssqldb.Error, class uint8, number int32) bool {
 	return nativeError.SQLErrorClass() == class && nativeError.SQLErrorNumber() == number
 }
 
+var dataErrorTruncationRe = regexp.MustCompile(`String or binary data would be truncated in table '(?:([^'.]+)\.)?([^'.]+)\.([^'.]+)', column '([^']+)'`)
+
+func dataError(nativeError mssqldb.Error) error {
+	// 2628 - String or binary data would be truncated in table '%.*ls', column '%.*ls'. Truncated value: '%.*ls'.
+	if isErrorClassAndNumber(nativeError, 16, 2628) {
+		if match := dataErrorTruncationRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.DataError{
+				Reason:  dberrors.StringTruncation,
+				Table:   match[3],
+				Column:  match[4],
+				Schema:  match[2],
+				DbError: newDbError(nativeError),
+			}
+		}
+	}
+
+	if isDataException(nativeError) {
+		return &dberrors.DataError{
+			Reason:  dataErrorReason(nativeError),
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func dataErrorReason(nativeError mssqldb.Error) dberrors.DataErrorReason {
+	switch nativeError.SQLErrorNumber() {
+	case 241, 242:
+		return dberrors.InvalidDatetime
+	case 245, 8114:
+		return dberrors.InvalidTextRepresentation
+	case 2628, 8152:
+		return dberrors.StringTruncation
+	case 220, 8115:
+		return dberrors.NumericOutOfRange
+	case 8134:
+		return dberrors.DivisionByZero
+	case 13609:
+		return dberrors.InvalidJSON
+	}
+
+	return ""
+}
+
 func isDataException(nativeError mssqldb.Error) bool {
+	// 220 - Arithmetic overflow error for data type tinyint, value = 256.
 	// 241 - Conversion failed when converting date and/or time from character string.
 	// 242 - The conversion of a nvarchar data type to a datetime data type resulted in an out-of-range value.
 	// 245 - Conversion failed when converting the nvarchar value 'lol' to data type int.
+	// 2628 - String or binary data would be truncated in table 'x', column 'y'. Truncated value: 'z'.
+	// 8114 - Error converting data type nvarchar to numeric.
+	// 8115 - Arithmetic overflow error converting expression to data type int.
+	// 8134 - Divide by zero error encountered.
 	// 8152 - String or binary data would be truncated.
-	return isErrorClassAndNumber(nativeError, 16, 241) ||
+	// 13609 - JSON text is not properly formatted.
+	return isErrorClassAndNumber(nativeError, 16, 220) ||
+		isErrorClassAndNumber(nativeError, 16, 241) ||
 		isErrorClassAndNumber(nativeError, 16, 242) ||
 		isErrorClassAndNumber(nativeError, 16, 245) ||
-		isErrorClassAndNumber(nativeError, 16, 8152)
+		isErrorClassAndNumber(nativeError, 16, 2628) ||
+		isErrorClassAndNumber(nativeError, 16, 8114) ||
+		isErrorClassAndNumber(nativeError, 16, 8115) ||
+		isErrorClassAndNumber(nativeError, 16, 8134) ||
+		isErrorClassAndNumber(nativeError, 16, 8152) ||
+		isErrorClassAndNumber(nativeError, 16, 13609)
 }
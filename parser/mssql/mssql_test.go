@@ -0,0 +1,177 @@
+package mssql
+
+import (
+	"testing"
+
+	mssqldb "github.com/denisenkom/go-mssqldb"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataError(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		err      mssqldb.Error
+		expected *dberrors.DataError
+	}{{
+		name: "truncated in database table",
+		err: mssqldb.Error{
+			Number:  2628,
+			Class:   16,
+			Message: "String or binary data would be truncated in table 'db_errors_test.dbo.users', column 'name'. Truncated value: 'Jane'.",
+		},
+		expected: &dberrors.DataError{
+			Reason: dberrors.StringTruncation,
+			Schema: "dbo",
+			Table:  "users",
+			Column: "name",
+		},
+	}, {
+		name: "truncated in schema table",
+		err: mssqldb.Error{
+			Number:  2628,
+			Class:   16,
+			Message: "String or binary data would be truncated in table 'dbo.users', column 'name'. Truncated value: 'Jane'.",
+		},
+		expected: &dberrors.DataError{
+			Reason: dberrors.StringTruncation,
+			Schema: "dbo",
+			Table:  "users",
+			Column: "name",
+		},
+	}, {
+		name: "truncated without table",
+		err:  mssqldb.Error{Number: 8152, Class: 16, Message: "String or binary data would be truncated."},
+		expected: &dberrors.DataError{
+			Reason: dberrors.StringTruncation,
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expected.DbError = newDbError(tc.err)
+			assert.Equal(t, tc.expected, Parse(tc.err))
+		})
+	}
+}
+
+func TestConcurrencyError(t *testing.T) {
+	for _, tc := range []struct {
+		err  mssqldb.Error
+		kind dberrors.Kind
+	}{
+		{mssqldb.Error{Number: 1205, Class: 13, Message: "Transaction (Process ID 52) was deadlocked on lock resources with another process and has been chosen as the deadlock victim. Rerun the transaction."}, dberrors.KindDeadlock},
+		{mssqldb.Error{Number: 3960, Class: 16, Message: "Snapshot isolation transaction aborted due to update conflict."}, dberrors.KindSerializationFailure},
+		{mssqldb.Error{Number: 1222, Class: 16, Message: "Lock request time out period exceeded."}, dberrors.KindLockTimeout},
+	} {
+		t.Run(tc.err.Message, func(t *testing.T) {
+			parsedErr := Parse(tc.err)
+
+			assert.Equal(t, tc.kind, dberrors.KindOf(parsedErr))
+			assert.True(t, dberrors.IsRetryable(parsedErr))
+		})
+	}
+}
+
+func TestConnectionError(t *testing.T) {
+	for _, tc := range []struct {
+		err           error
+		maybeExecuted bool
+	}{
+		{mssqldb.Error{Number: 6005, Class: 14, Message: "SHUTDOWN is in progress."}, false},
+		{mssqldb.Error{Number: 596, Class: 21, Message: "Cannot continue the execution because the session is in the kill state."}, true},
+		{mssqldb.StreamError{Message: "Invalid TDS stream: EOF"}, true},
+	} {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			connectionError, ok := Parse(tc.err).(*dberrors.ConnectionError)
+
+			if assert.True(t, ok) {
+				assert.Equal(t, tc.maybeExecuted, connectionError.MaybeExecuted)
+				assert.Equal(t, dberrors.MSSQL, connectionError.Dialect())
+			}
+		})
+	}
+}
+
+func TestTimeoutError(t *testing.T) {
+	nativeError := mssqldb.Error{Number: 3980, Class: 16, Message: "The request failed to run because the batch is aborted, this can be caused by abort signal sent from client, or another request is running in the same session, which makes the session busy."}
+
+	assert.Equal(t, &dberrors.CanceledError{
+		Source:  dberrors.ClientSource,
+		DbError: newDbError(nativeError),
+	}, Parse(nativeError))
+}
+
+func TestPermissionError(t *testing.T) {
+	object := mssqldb.Error{Number: 229, Class: 14, Message: "The SELECT permission was denied on the object 'users', database 'app', schema 'dbo'."}
+	assert.Equal(t, &dberrors.PermissionDeniedError{
+		Privilege:  "SELECT",
+		ObjectType: "object",
+		Object:     "users",
+		Schema:     "dbo",
+		DbError:    newDbError(object),
+	}, Parse(object))
+
+	authentication := mssqldb.Error{Number: 18456, Class: 14, Message: "Login failed for user 'app'."}
+	assert.Equal(t, &dberrors.AuthenticationError{
+		User:    "app",
+		DbError: newDbError(authentication),
+	}, Parse(authentication))
+}
+
+func TestUndefinedObjectError(t *testing.T) {
+	for _, tc := range []struct {
+		err      mssqldb.Error
+		expected *dberrors.UndefinedObjectError
+	}{
+		{mssqldb.Error{Number: 208, Class: 16, Message: "Invalid object name 'dbo.users'."}, &dberrors.UndefinedObjectError{ObjectType: "table", Name: "users", Schema: "dbo"}},
+		{mssqldb.Error{Number: 207, Class: 16, Message: "Invalid column name 'emial'."}, &dberrors.UndefinedObjectError{ObjectType: "column", Name: "emial"}},
+		{mssqldb.Error{Number: 2812, Class: 16, Message: "Could not find stored procedure 'dbo.archive'."}, &dberrors.UndefinedObjectError{ObjectType: "procedure", Name: "archive", Schema: "dbo"}},
+	} {
+		t.Run(tc.err.Message, func(t *testing.T) {
+			tc.expected.DbError = newDbError(tc.err)
+			assert.Equal(t, tc.expected, Parse(tc.err))
+		})
+	}
+}
+
+func TestSyntaxError(t *testing.T) {
+	for _, tc := range []struct {
+		err   mssqldb.Error
+		token string
+	}{
+		{mssqldb.Error{Number: 102, Class: 15, LineNo: 2, Message: "Incorrect syntax near 'frm'."}, "frm"},
+		{mssqldb.Error{Number: 156, Class: 15, LineNo: 2, Message: "Incorrect syntax near the keyword 'where'."}, "where"},
+	} {
+		t.Run(tc.err.Message, func(t *testing.T) {
+			assert.Equal(t, &dberrors.SyntaxError{
+				Token:   tc.token,
+				Line:    2,
+				DbError: newDbError(tc.err),
+			}, Parse(tc.err))
+		})
+	}
+}
+
+func TestResourceExhaustedError(t *testing.T) {
+	for _, tc := range []struct {
+		err      mssqldb.Error
+		resource string
+	}{
+		{mssqldb.Error{Number: 9002, Class: 17, Message: "The transaction log for database 'app' is full due to 'LOG_BACKUP'."}, "disk"},
+		{mssqldb.Error{Number: 701, Class: 17, Message: "There is insufficient system memory in resource pool 'default' to run this query."}, "memory"},
+	} {
+		t.Run(tc.err.Message, func(t *testing.T) {
+			assert.Equal(t, &dberrors.ResourceExhaustedError{
+				Resource: tc.resource,
+				DbError:  newDbError(tc.err),
+			}, Parse(tc.err))
+		})
+	}
+}
+
+func TestReadOnlyError(t *testing.T) {
+	nativeError := mssqldb.Error{Number: 3906, Class: 16, Message: `Failed to update database "app" because the database is read-only.`}
+
+	assert.Equal(t, &dberrors.ReadOnlyError{
+		DbError: newDbError(nativeError),
+	}, Parse(nativeError))
+}
@@ -5,14 +5,24 @@ import (
 	"github.com/stackworx-go/dberrors"
 	"github.com/stackworx-go/dberrors/internal/dialect"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/go-sql-driver/mysql"
 )
 
+func init() {
+	dberrors.RegisterParser(dialect.MYSQL, Parse)
+}
+
 // Parse Parse export
 func Parse(err error) error {
 	var nativeError *mysql.MySQLError
 	if errors.As(err, &nativeError) {
+		if err := applicationError(nativeError); err != nil {
+			return err
+		}
+
 		if err := uniqueViolationError(nativeError); err != nil {
 			return err
 		}
@@ -29,10 +39,53 @@ func Parse(err error) error {
 			return err
 		}
 
-		if isDataException(nativeError) {
-			return &dberrors.DataError{
-				DbError: dberrors.NewDbError(nativeError, dialect.MYSQL),
-			}
+		if err := dataError(nativeError); err != nil {
+			return err
+		}
+
+		if err := concurrencyError(nativeError); err != nil {
+			return err
+		}
+
+		if err := connectionError(nativeError); err != nil {
+			return err
+		}
+
+		if err := timeoutError(nativeError); err != nil {
+			return err
+		}
+
+		if err := permissionError(nativeError); err != nil {
+			return err
+		}
+
+		if err := undefinedObjectError(nativeError); err != nil {
+			return err
+		}
+
+		if err := syntaxError(nativeError); err != nil {
+			return err
+		}
+
+		if err := resourceExhaustedError(nativeError); err != nil {
+			return err
+		}
+
+		if err := readOnlyError(nativeError); err != nil {
+			return err
+		}
+
+		// Checked last, the error numbers of SIGNAL may collide with built-in errors
+		if err := customErrnoError(nativeError); err != nil {
+			return err
+		}
+	}
+
+	// Returned by the driver when the connection broke while the statement was in flight
+	if errors.Is(err, mysql.ErrInvalidConn) {
+		return &dberrors.ConnectionError{
+			MaybeExecuted: true,
+			DbError:       dberrors.NewDbError(err, dialect.MYSQL),
 		}
 	}
 
@@ -49,7 +102,7 @@ func uniqueViolationError(nativeError *mysql.MySQLError) error {
 		if match := uniqueViolationErrorRe.FindStringSubmatch(nativeError.Message); match != nil {
 			return &dberrors.UniqueViolationError{
 				Constraint: match[2],
-				DbError:    dberrors.NewDbError(nativeError, dialect.MYSQL),
+				DbError:    newDbError(nativeError),
 			}
 		}
 	}
@@ -66,7 +119,7 @@ func notNullViolationError(nativeError *mysql.MySQLError) error {
 		if match := notNullViolationErrorBadNullErrorRe.FindStringSubmatch(nativeError.Message); match != nil {
 			return &dberrors.NotNullViolationError{
 				Column:  match[1],
-				DbError: dberrors.NewDbError(nativeError, dialect.MYSQL),
+				DbError: newDbError(nativeError),
 			}
 		}
 	}
@@ -75,7 +128,7 @@ func notNullViolationError(nativeError *mysql.MySQLError) error {
 	if nativeError.Number == 1364 {
 		if match := notNullViolationErrorNoDefaultForFieldRe.FindStringSubmatch(nativeError.Message); match != nil {
 			return &dberrors.NotNullViolationError{
-				DbError: dberrors.NewDbError(nativeError, dialect.MYSQL),
+				DbError: newDbError(nativeError),
 			}
 		}
 	}
@@ -97,7 +150,7 @@ func foreignKeyViolationError(nativeError *mysql.MySQLError) error {
 	if nativeError.Number == 1216 || nativeError.Number == 23000 {
 		if match := foreignKeyViolationErrorNoReferencedRe.FindStringSubmatch(nativeError.Message); match != nil {
 			return &dberrors.ForeignKeyViolationError{
-				DbError: dberrors.NewDbError(nativeError, dialect.MYSQL),
+				DbError: newDbError(nativeError),
 			}
 		}
 	}
@@ -109,7 +162,7 @@ func foreignKeyViolationError(nativeError *mysql.MySQLError) error {
 				Schema:     match[1],
 				Table:      match[2],
 				Constraint: match[3],
-				DbError:    dberrors.NewDbError(nativeError, dialect.MYSQL),
+				DbError:    newDbError(nativeError),
 			}
 		}
 	}
@@ -121,7 +174,7 @@ func foreignKeyViolationError(nativeError *mysql.MySQLError) error {
 				//Schema: nativeError.Schema,
 				//Table: nativeError.Table,
 				//Constraint: nativeError.Constraint,
-				DbError: dberrors.NewDbError(nativeError, dialect.MYSQL),
+				DbError: newDbError(nativeError),
 			}
 		}
 	}
@@ -135,16 +188,371 @@ func checkViolationError(nativeError *mysql.MySQLError) error {
 		return &dberrors.CheckViolationError{
 			//Table:nativeError.Table,
 			//Constraint: nativeError.Constraint,
-			DbError: dberrors.NewDbError(nativeError, dialect.MYSQL),
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+var dataErrorColumnRe = regexp.MustCompile(`for column '(.+)' at row (\d+)`)
+var dataErrorIncorrectValueRe = regexp.MustCompile(`^Incorrect (\w+) value`)
+var dataErrorJSONColumnRe = regexp.MustCompile(`in value for column '(?:(.+)\.)?(.+)'`)
+
+func dataError(nativeError *mysql.MySQLError) error {
+	if !isDataException(nativeError) {
+		return nil
+	}
+
+	dataError := &dberrors.DataError{
+		Reason:  dataErrorReason(nativeError),
+		DbError: newDbError(nativeError),
+	}
+
+	if match := dataErrorColumnRe.FindStringSubmatch(nativeError.Message); match != nil {
+		dataError.Column = match[1]
+		dataError.Row, _ = strconv.Atoi(match[2])
+	} else if match := dataErrorJSONColumnRe.FindStringSubmatch(nativeError.Message); match != nil {
+		dataError.Table = match[1]
+		dataError.Column = match[2]
+	}
+
+	return dataError
+}
+
+func dataErrorReason(nativeError *mysql.MySQLError) dberrors.DataErrorReason {
+	switch nativeError.Number {
+	// ER_DATA_TOO_LONG - 1406
+	case 1406:
+		return dberrors.StringTruncation
+	// ER_WARN_DATA_OUT_OF_RANGE - 1264
+	case 1264:
+		return dberrors.NumericOutOfRange
+	// ER_DIVISION_BY_ZERO - 1365
+	case 1365:
+		return dberrors.DivisionByZero
+	// WARN_DATA_TRUNCATED - 1265
+	// Also raised for values which are not part of an enum or set,
+	// the message does not report the column type so they can not be told apart.
+	case 1265:
+		return dberrors.StringTruncation
+	// ER_INVALID_JSON_TEXT - 3140
+	case 3140:
+		return dberrors.InvalidJSON
+	// ER_TRUNCATED_WRONG_VALUE - 1292
+	// ER_TRUNCATED_WRONG_VALUE_FOR_FIELD - 1366
+	case 1292, 1366:
+		if match := dataErrorIncorrectValueRe.FindStringSubmatch(nativeError.Message); match != nil {
+			switch strings.ToLower(match[1]) {
+			case "date", "datetime", "time", "timestamp", "year":
+				return dberrors.InvalidDatetime
+			}
+		}
+
+		return dberrors.InvalidTextRepresentation
+	}
+
+	return ""
+}
+
+func concurrencyError(nativeError *mysql.MySQLError) error {
+	// ER_LOCK_DEADLOCK - 1213
+	if nativeError.Number == 1213 {
+		return &dberrors.DeadlockError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	// ER_LOCK_WAIT_TIMEOUT - 1205
+	if nativeError.Number == 1205 {
+		return &dberrors.LockTimeoutError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func connectionError(nativeError *mysql.MySQLError) error {
+	// ER_SERVER_SHUTDOWN - 1053
+	// Lost connections (the CR_* client codes of libmysqlclient) are reported by the driver
+	// as mysql.ErrInvalidConn or driver.ErrBadConn instead of a MySQLError.
+	if nativeError.Number == 1053 {
+		return &dberrors.ConnectionError{
+			MaybeExecuted: false,
+			DbError:       newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func timeoutError(nativeError *mysql.MySQLError) error {
+	// ER_QUERY_TIMEOUT - 3024
+	if nativeError.Number == 3024 {
+		return &dberrors.TimeoutError{
+			Source:  dberrors.ServerSource,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	// ER_QUERY_INTERRUPTED - 1317
+	if nativeError.Number == 1317 {
+		return &dberrors.CanceledError{
+			Source:  dberrors.ServerSource,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+var permissionErrorTableRe = regexp.MustCompile(`(\w+) command denied to user '(.*)'@'.*' for table '(?:(.+)\.)?(.+)'`)
+var permissionErrorColumnRe = regexp.MustCompile(`(\w+) command denied to user '(.*)'@'.*' for column '(.+)' in table '(.+)'`)
+var permissionErrorDatabaseRe = regexp.MustCompile(`Access denied for user '(.*)'@'.*' to database '(.+)'`)
+var authenticationErrorRe = regexp.MustCompile(`Access denied for user '(.*)'@'.*'`)
+
+func permissionError(nativeError *mysql.MySQLError) error {
+	// ER_TABLEACCESS_DENIED_ERROR - 1142
+	if nativeError.Number == 1142 {
+		if match := permissionErrorTableRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.PermissionDeniedError{
+				User:       match[2],
+				Privilege:  match[1],
+				ObjectType: "table",
+				Object:     match[4],
+				Schema:     match[3],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	// ER_COLUMNACCESS_DENIED_ERROR - 1143
+	if nativeError.Number == 1143 {
+		if match := permissionErrorColumnRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.PermissionDeniedError{
+				User:       match[2],
+				Privilege:  match[1],
+				ObjectType: "column",
+				Object:     match[4] + "." + match[3],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	// ER_DBACCESS_DENIED_ERROR - 1044
+	if nativeError.Number == 1044 {
+		if match := permissionErrorDatabaseRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.PermissionDeniedError{
+				User:       match[1],
+				ObjectType: "database",
+				Object:     match[2],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	// ER_ACCESS_DENIED_ERROR - 1045
+	if nativeError.Number == 1045 {
+		authenticationError := &dberrors.AuthenticationError{
+			DbError: newDbError(nativeError),
+		}
+
+		if match := authenticationErrorRe.FindStringSubmatch(nativeError.Message); match != nil {
+			authenticationError.User = match[1]
+		}
+
+		return authenticationError
+	}
+
+	return nil
+}
+
+var undefinedObjectErrorTableRe = regexp.MustCompile(`Table '(?:(.+)\.)?(.+)' doesn't exist`)
+var undefinedObjectErrorColumnRe = regexp.MustCompile(`Unknown column '(?:.+\.)?(.+)' in`)
+var undefinedObjectErrorDatabaseRe = regexp.MustCompile(`Unknown database '(.+)'`)
+var undefinedObjectErrorRoutineRe = regexp.MustCompile(`(FUNCTION|PROCEDURE) (?:(.+)\.)?(.+) does not exist`)
+
+func undefinedObjectError(nativeError *mysql.MySQLError) error {
+	// ER_NO_SUCH_TABLE - 1146
+	if nativeError.Number == 1146 {
+		if match := undefinedObjectErrorTableRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.UndefinedObjectError{
+				ObjectType: "table",
+				Name:       match[2],
+				Schema:     match[1],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	// ER_BAD_FIELD_ERROR - 1054
+	if nativeError.Number == 1054 {
+		if match := undefinedObjectErrorColumnRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.UndefinedObjectError{
+				ObjectType: "column",
+				Name:       match[1],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	// ER_BAD_DB_ERROR - 1049
+	if nativeError.Number == 1049 {
+		if match := undefinedObjectErrorDatabaseRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.UndefinedObjectError{
+				ObjectType: "database",
+				Name:       match[1],
+				DbError:    newDbError(nativeError),
+			}
+		}
+	}
+
+	// ER_SP_DOES_NOT_EXIST - 1305
+	if nativeError.Number == 1305 {
+		if match := undefinedObjectErrorRoutineRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.UndefinedObjectError{
+				ObjectType: strings.ToLower(match[1]),
+				Name:       match[3],
+				Schema:     match[2],
+				DbError:    newDbError(nativeError),
+			}
 		}
 	}
 
 	return nil
 }
 
+var syntaxErrorRe = regexp.MustCompile(`(?s)near '(.*)' at line (\d+)$`)
+
+func syntaxError(nativeError *mysql.MySQLError) error {
+	// ER_PARSE_ERROR - 1064
+	if nativeError.Number == 1064 {
+		syntaxError := &dberrors.SyntaxError{
+			DbError: newDbError(nativeError),
+		}
+
+		// The remainder of the query is reported, starting at the offending token
+		if match := syntaxErrorRe.FindStringSubmatch(nativeError.Message); match != nil {
+			if fields := strings.Fields(match[1]); len(fields) > 0 {
+				syntaxError.Token = fields[0]
+			}
+			syntaxError.Line, _ = strconv.Atoi(match[2])
+		}
+
+		return syntaxError
+	}
+
+	return nil
+}
+
+var readOnlyErrorOptionRe = regexp.MustCompile(`--(?:super-)?read-only`)
+
+func resourceExhaustedError(nativeError *mysql.MySQLError) error {
+	switch nativeError.Number {
+	// ER_DISK_FULL - 1021
+	// ER_RECORD_FILE_FULL - 1114
+	case 1021, 1114:
+		return &dberrors.ResourceExhaustedError{
+			Resource: "disk",
+			DbError:  newDbError(nativeError),
+		}
+	// ER_OUTOFMEMORY - 1037
+	// ER_OUT_OF_SORTMEMORY - 1038
+	// ER_OUT_OF_RESOURCES - 1041
+	case 1037, 1038, 1041:
+		return &dberrors.ResourceExhaustedError{
+			Resource: "memory",
+			DbError:  newDbError(nativeError),
+		}
+	// ER_CON_COUNT_ERROR - 1040
+	case 1040:
+		return &dberrors.ResourceExhaustedError{
+			Resource: "connections",
+			DbError:  newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func readOnlyError(nativeError *mysql.MySQLError) error {
+	// ER_OPTION_PREVENTS_STATEMENT - 1290
+	// Also raised for other options such as --secure-file-priv
+	if nativeError.Number == 1290 && readOnlyErrorOptionRe.MatchString(nativeError.Message) {
+		return &dberrors.ReadOnlyError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	// ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION - 1792
+	// ER_READ_ONLY_MODE - 1836
+	if nativeError.Number == 1792 || nativeError.Number == 1836 {
+		return &dberrors.ReadOnlyError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func applicationError(nativeError *mysql.MySQLError) error {
+	// ER_SIGNAL_EXCEPTION - 1644
+	// Raised by SIGNAL for user defined SQLSTATEs such as 45000 without a MYSQL_ERRNO
+	if nativeError.Number == 1644 {
+		return &dberrors.ApplicationError{
+			Code:    strconv.Itoa(int(nativeError.Number)),
+			Message: nativeError.Message,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func customErrnoError(nativeError *mysql.MySQLError) error {
+	// SIGNAL ... SET MYSQL_ERRNO = n raises error n, the driver does not report the SQLSTATE.
+	// Error numbers reserved by MySQL (1-4999 and 10000-49999) can not be told apart
+	// from built-in errors and are left unclassified unless they are classified otherwise.
+	if nativeError.Number >= 5000 && nativeError.Number < 10000 || nativeError.Number >= 50000 {
+		return &dberrors.ApplicationError{
+			Code:    strconv.Itoa(int(nativeError.Number)),
+			Message: nativeError.Message,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+// Diagnostics Diagnostics export
+func Diagnostics(err error) dberrors.Diagnostics {
+	var nativeError *mysql.MySQLError
+	if !errors.As(err, &nativeError) {
+		return dberrors.Diagnostics{}
+	}
+
+	return dberrors.Diagnostics{
+		Code: strconv.Itoa(int(nativeError.Number)),
+	}
+}
+
+func newDbError(nativeError *mysql.MySQLError) dberrors.DbError {
+	return dberrors.NewDbErrorWithDiagnostics(nativeError, dialect.MYSQL, Diagnostics(nativeError))
+}
+
 func isDataException(nativeError *mysql.MySQLError) bool {
 	// ER_DATA_TOO_LONG - 1406
 	// ER_TRUNCATED_WRONG_VALUE - 1292
 	// ER_TRUNCATED_WRONG_VALUE_FOR_FIELD - 1366
-	return nativeError.Number == 1406 || nativeError.Number == 1292 || nativeError.Number == 1366
+	// ER_WARN_DATA_OUT_OF_RANGE - 1264
+	// WARN_DATA_TRUNCATED - 1265
+	// ER_DIVISION_BY_ZERO - 1365
+	// ER_INVALID_JSON_TEXT - 3140
+	switch nativeError.Number {
+	case 1406, 1292, 1366, 1264, 1265, 1365, 3140:
+		return true
+	}
+
+	return false
 }
@@ -0,0 +1,210 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataError(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		err      *mysql.MySQLError
+		expected *dberrors.DataError
+	}{{
+		name: "data too long",
+		err:  &mysql.MySQLError{Number: 1406, Message: "Data too long for column 'name' at row 3"},
+		expected: &dberrors.DataError{
+			Reason: dberrors.StringTruncation,
+			Column: "name",
+			Row:    3,
+		},
+	}, {
+		// Raised for enum values as well as other truncated values
+		name: "data truncated",
+		err:  &mysql.MySQLError{Number: 1265, Message: "Data truncated for column 'status' at row 1"},
+		expected: &dberrors.DataError{
+			Reason: dberrors.StringTruncation,
+			Column: "status",
+			Row:    1,
+		},
+	}, {
+		name: "incorrect datetime value",
+		err:  &mysql.MySQLError{Number: 1292, Message: "Incorrect datetime value: 'tomorrow' for column 'born_at' at row 2"},
+		expected: &dberrors.DataError{
+			Reason: dberrors.InvalidDatetime,
+			Column: "born_at",
+			Row:    2,
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expected.DbError = newDbError(tc.err)
+			assert.Equal(t, tc.expected, Parse(tc.err))
+		})
+	}
+}
+
+func TestConcurrencyError(t *testing.T) {
+	for _, tc := range []struct {
+		err  *mysql.MySQLError
+		kind dberrors.Kind
+	}{
+		{&mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock; try restarting transaction"}, dberrors.KindDeadlock},
+		{&mysql.MySQLError{Number: 1205, Message: "Lock wait timeout exceeded; try restarting transaction"}, dberrors.KindLockTimeout},
+	} {
+		t.Run(tc.err.Message, func(t *testing.T) {
+			parsedErr := Parse(tc.err)
+
+			assert.Equal(t, tc.kind, dberrors.KindOf(parsedErr))
+			assert.True(t, dberrors.IsRetryable(parsedErr))
+		})
+	}
+}
+
+func TestConnectionError(t *testing.T) {
+	for _, tc := range []struct {
+		err           error
+		maybeExecuted bool
+	}{
+		{&mysql.MySQLError{Number: 1053, Message: "Server shutdown in progress"}, false},
+		{mysql.ErrInvalidConn, true},
+	} {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			connectionError, ok := Parse(tc.err).(*dberrors.ConnectionError)
+
+			if assert.True(t, ok) {
+				assert.Equal(t, tc.maybeExecuted, connectionError.MaybeExecuted)
+				assert.Equal(t, dberrors.MYSQL, connectionError.Dialect())
+			}
+		})
+	}
+}
+
+func TestTimeoutError(t *testing.T) {
+	timeout := &mysql.MySQLError{Number: 3024, Message: "Query execution was interrupted, maximum statement execution time exceeded"}
+	assert.Equal(t, &dberrors.TimeoutError{
+		Source:  dberrors.ServerSource,
+		DbError: newDbError(timeout),
+	}, Parse(timeout))
+
+	interrupted := &mysql.MySQLError{Number: 1317, Message: "Query execution was interrupted"}
+	assert.Equal(t, &dberrors.CanceledError{
+		Source:  dberrors.ServerSource,
+		DbError: newDbError(interrupted),
+	}, Parse(interrupted))
+}
+
+func TestPermissionError(t *testing.T) {
+	table := &mysql.MySQLError{Number: 1142, Message: "SELECT command denied to user 'app'@'localhost' for table 'users'"}
+	assert.Equal(t, &dberrors.PermissionDeniedError{
+		User:       "app",
+		Privilege:  "SELECT",
+		ObjectType: "table",
+		Object:     "users",
+		DbError:    newDbError(table),
+	}, Parse(table))
+
+	column := &mysql.MySQLError{Number: 1143, Message: "UPDATE command denied to user 'app'@'localhost' for column 'salary' in table 'employees'"}
+	assert.Equal(t, &dberrors.PermissionDeniedError{
+		User:       "app",
+		Privilege:  "UPDATE",
+		ObjectType: "column",
+		Object:     "employees.salary",
+		DbError:    newDbError(column),
+	}, Parse(column))
+
+	authentication := &mysql.MySQLError{Number: 1045, Message: "Access denied for user 'app'@'localhost' (using password: YES)"}
+	assert.Equal(t, &dberrors.AuthenticationError{
+		User:    "app",
+		DbError: newDbError(authentication),
+	}, Parse(authentication))
+}
+
+func TestUndefinedObjectError(t *testing.T) {
+	for _, tc := range []struct {
+		err      *mysql.MySQLError
+		expected *dberrors.UndefinedObjectError
+	}{
+		{&mysql.MySQLError{Number: 1146, Message: "Table 'app.users' doesn't exist"}, &dberrors.UndefinedObjectError{ObjectType: "table", Name: "users", Schema: "app"}},
+		{&mysql.MySQLError{Number: 1054, Message: "Unknown column 'emial' in 'field list'"}, &dberrors.UndefinedObjectError{ObjectType: "column", Name: "emial"}},
+		{&mysql.MySQLError{Number: 1049, Message: "Unknown database 'billing'"}, &dberrors.UndefinedObjectError{ObjectType: "database", Name: "billing"}},
+		{&mysql.MySQLError{Number: 1305, Message: "FUNCTION app.slugify does not exist"}, &dberrors.UndefinedObjectError{ObjectType: "function", Name: "slugify", Schema: "app"}},
+	} {
+		t.Run(tc.err.Message, func(t *testing.T) {
+			tc.expected.DbError = newDbError(tc.err)
+			assert.Equal(t, tc.expected, Parse(tc.err))
+		})
+	}
+}
+
+func TestSyntaxError(t *testing.T) {
+	nativeError := &mysql.MySQLError{
+		Number:  1064,
+		Message: "You have an error in your SQL syntax; check the manual that corresponds to your MySQL server version for the right syntax to use near 'where id = 1' at line 2",
+	}
+
+	assert.Equal(t, &dberrors.SyntaxError{
+		Token:   "where",
+		Line:    2,
+		DbError: newDbError(nativeError),
+	}, Parse(nativeError))
+}
+
+func TestResourceExhaustedError(t *testing.T) {
+	for _, tc := range []struct {
+		err      *mysql.MySQLError
+		resource string
+	}{
+		{&mysql.MySQLError{Number: 1114, Message: "The table 'users' is full"}, "disk"},
+		{&mysql.MySQLError{Number: 1038, Message: "Out of sort memory, consider increasing server sort buffer size"}, "memory"},
+		{&mysql.MySQLError{Number: 1040, Message: "Too many connections"}, "connections"},
+	} {
+		t.Run(tc.err.Message, func(t *testing.T) {
+			assert.Equal(t, &dberrors.ResourceExhaustedError{
+				Resource: tc.resource,
+				DbError:  newDbError(tc.err),
+			}, Parse(tc.err))
+		})
+	}
+}
+
+func TestReadOnlyError(t *testing.T) {
+	for _, err := range []*mysql.MySQLError{
+		{Number: 1290, Message: "The MySQL server is running with the --super-read-only option so it cannot execute this statement"},
+		{Number: 1792, Message: "Cannot execute statement in a READ ONLY transaction."},
+	} {
+		t.Run(err.Message, func(t *testing.T) {
+			assert.Equal(t, &dberrors.ReadOnlyError{
+				DbError: newDbError(err),
+			}, Parse(err))
+		})
+	}
+
+	// Other options prevent statements as well
+	assert.Nil(t, Parse(&mysql.MySQLError{Number: 1290, Message: "The MySQL server is running with the --secure-file-priv option so it cannot execute this statement"}))
+}
+
+func TestApplicationError(t *testing.T) {
+	for _, tc := range []struct {
+		err  *mysql.MySQLError
+		code string
+	}{
+		{&mysql.MySQLError{Number: 1644, Message: "insufficient funds"}, "1644"},
+		// SIGNAL SQLSTATE '45000' SET MYSQL_ERRNO = 5001
+		{&mysql.MySQLError{Number: 5001, Message: "insufficient funds"}, "5001"},
+		{&mysql.MySQLError{Number: 50001, Message: "account frozen"}, "50001"},
+	} {
+		t.Run(tc.code, func(t *testing.T) {
+			assert.Equal(t, &dberrors.ApplicationError{
+				Code:    tc.code,
+				Message: tc.err.Message,
+				DbError: newDbError(tc.err),
+			}, Parse(tc.err))
+		})
+	}
+
+	// A MYSQL_ERRNO reserved by MySQL is not an application error
+	assert.Nil(t, Parse(&mysql.MySQLError{Number: 1999, Message: "insufficient funds"}))
+}
@@ -6,20 +6,68 @@ import (
 	"github.com/stackworx-go/dberrors"
 	"github.com/stackworx-go/dberrors/internal/dialect"
 	"regexp"
+	"strconv"
 )
 
+func init() {
+	dberrors.RegisterParser(dialect.POSTGRES, Parse)
+}
+
 // Parse Parse export
 func Parse(err error) error {
 	var nativeError *pq.Error
 	if errors.As(err, &nativeError) {
+		if err := applicationError(nativeError); err != nil {
+			return err
+		}
+
 		if err := constraintViolationError(nativeError); err != nil {
 			return err
 		}
 
-		if isDataException(nativeError) {
-			return &dberrors.DataError{
-				DbError: dberrors.NewDbError(nativeError, dialect.POSTGRES),
-			}
+		if err := dataError(nativeError); err != nil {
+			return err
+		}
+
+		if err := concurrencyError(nativeError); err != nil {
+			return err
+		}
+
+		if err := connectionError(nativeError); err != nil {
+			return err
+		}
+
+		if err := timeoutError(nativeError); err != nil {
+			return err
+		}
+
+		if err := permissionError(nativeError); err != nil {
+			return err
+		}
+
+		if err := undefinedObjectError(nativeError); err != nil {
+			return err
+		}
+
+		if err := syntaxError(nativeError); err != nil {
+			return err
+		}
+
+		if err := resourceExhaustedError(nativeError); err != nil {
+			return err
+		}
+
+		if err := readOnlyError(nativeError); err != nil {
+			return err
+		}
+
+		if err := abortedTransactionError(nativeError); err != nil {
+			return err
+		}
+
+		// Checked last, pq does not know every code of newer postgres versions
+		if err := customCodeError(nativeError); err != nil {
+			return err
 		}
 	}
 
@@ -49,7 +97,10 @@ func constraintViolationError(nativeError *pq.Error) error {
 }
 
 var uniqueViolationErrorDetailRe = regexp.MustCompile(`Key \((.+)\)=\(.+\) already exists`)
-var uniqueViolationErrorConstraintRe = regexp.MustCompile(`(.+) unique`)
+
+// Constraints are matched by name, e.g. by IgnoreUnique and GetOrCreate.
+// The " unique" suffix of constraints declared as "name unique" is dropped.
+var uniqueViolationErrorConstraintRe = regexp.MustCompile(`^(.+?)(?: unique)?$`)
 
 func uniqueViolationError(nativeError *pq.Error) error {
 	if nativeError.Code == "23505" {
@@ -60,7 +111,7 @@ func uniqueViolationError(nativeError *pq.Error) error {
 					Schema:     nativeError.Schema,
 					Constraint: constraintMatch[1],
 					Column:     match[1],
-					DbError:    dberrors.NewDbError(nativeError, dialect.POSTGRES),
+					DbError:    newDbError(nativeError),
 				}
 			}
 		}
@@ -74,7 +125,7 @@ func notNullViolationError(nativeError *pq.Error) error {
 		return &dberrors.NotNullViolationError{
 			Table:   nativeError.Table,
 			Column:  nativeError.Column,
-			DbError: dberrors.NewDbError(nativeError, dialect.POSTGRES),
+			DbError: newDbError(nativeError),
 		}
 	}
 
@@ -87,7 +138,7 @@ func foreignKeyViolationError(nativeError *pq.Error) error {
 			Schema:     nativeError.Schema,
 			Table:      nativeError.Table,
 			Constraint: nativeError.Constraint,
-			DbError:    dberrors.NewDbError(nativeError, dialect.POSTGRES),
+			DbError:    newDbError(nativeError),
 		}
 	}
 
@@ -100,13 +151,371 @@ func checkViolationError(nativeError *pq.Error) error {
 		return &dberrors.CheckViolationError{
 			Table:      nativeError.Table,
 			Constraint: nativeError.Constraint,
-			DbError:    dberrors.NewDbError(nativeError, dialect.POSTGRES),
+			DbError:    newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+var dataErrorEnumRe = regexp.MustCompile(`invalid input value for enum`)
+var dataErrorJSONRe = regexp.MustCompile(`invalid input syntax for type jsonb?\b`)
+
+func dataError(nativeError *pq.Error) error {
+	if isDataException(nativeError) {
+		return &dberrors.DataError{
+			Reason:  dataErrorReason(nativeError),
+			Table:   nativeError.Table,
+			Column:  nativeError.Column,
+			Schema:  nativeError.Schema,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func dataErrorReason(nativeError *pq.Error) dberrors.DataErrorReason {
+	switch nativeError.Code {
+	// string_data_right_truncation
+	case "22001":
+		return dberrors.StringTruncation
+	// numeric_value_out_of_range
+	case "22003":
+		return dberrors.NumericOutOfRange
+	// invalid_datetime_format, datetime_field_overflow
+	case "22007", "22008":
+		return dberrors.InvalidDatetime
+	// division_by_zero
+	case "22012":
+		return dberrors.DivisionByZero
+	// invalid_json_text
+	case "22032":
+		return dberrors.InvalidJSON
+	// invalid_text_representation, also used for enums and json
+	case "22P02":
+		if dataErrorEnumRe.MatchString(nativeError.Message) {
+			return dberrors.InvalidEnumValue
+		}
+
+		if dataErrorJSONRe.MatchString(nativeError.Message) {
+			return dberrors.InvalidJSON
+		}
+
+		return dberrors.InvalidTextRepresentation
+	}
+
+	return ""
+}
+
+func concurrencyError(nativeError *pq.Error) error {
+	switch nativeError.Code {
+	// deadlock_detected
+	case "40P01":
+		return &dberrors.DeadlockError{
+			DbError: newDbError(nativeError),
+		}
+	// serialization_failure
+	case "40001":
+		return &dberrors.SerializationFailureError{
+			DbError: newDbError(nativeError),
+		}
+	// lock_not_available, raised by lock_timeout and NOWAIT
+	case "55P03":
+		return &dberrors.LockTimeoutError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func connectionError(nativeError *pq.Error) error {
+	// admin_shutdown, crash_shutdown and cannot_connect_now are reported with FATAL severity,
+	// pq replaces FATAL errors with driver.ErrBadConn so they never reach this parser.
+	switch nativeError.Code {
+	// connection_exception, connection_failure
+	// The statement may have completed.
+	case "08000", "08006":
+		return &dberrors.ConnectionError{
+			MaybeExecuted: true,
+			DbError:       newDbError(nativeError),
+		}
+	}
+
+	// sqlclient_unable_to_establish_sqlconnection, sqlserver_rejected_establishment_of_sqlconnection, ...
+	if nativeError.Code.Class() == "08" {
+		return &dberrors.ConnectionError{
+			MaybeExecuted: false,
+			DbError:       newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+var timeoutErrorStatementTimeoutRe = regexp.MustCompile(`^canceling statement due to statement timeout`)
+
+func timeoutError(nativeError *pq.Error) error {
+	// query_canceled, raised by statement_timeout as well as cancel requests
+	if nativeError.Code == "57014" {
+		if timeoutErrorStatementTimeoutRe.MatchString(nativeError.Message) {
+			return &dberrors.TimeoutError{
+				Source:  dberrors.ServerSource,
+				DbError: newDbError(nativeError),
+			}
+		}
+
+		// pq cancels the statement when the context is done, which is reported as
+		// "canceling statement due to user request", the same as pg_cancel_backend by an administrator.
+		// The source is left unknown as the two can not be told apart.
+		return &dberrors.CanceledError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	// idle_in_transaction_session_timeout
+	if nativeError.Code == "25P03" {
+		return &dberrors.TimeoutError{
+			Source:  dberrors.ServerSource,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+var permissionErrorObjectRe = regexp.MustCompile(`permission denied for (\w+) "?([^"]+)"?$`)
+var permissionErrorRowLevelSecurityRe = regexp.MustCompile(`violates row-level security policy for table "(.+)"`)
+var authenticationErrorUserRe = regexp.MustCompile(`for user "(.+)"`)
+
+func permissionError(nativeError *pq.Error) error {
+	// insufficient_privilege
+	if nativeError.Code == "42501" {
+		if match := permissionErrorRowLevelSecurityRe.FindStringSubmatch(nativeError.Message); match != nil {
+			return &dberrors.PermissionDeniedError{
+				ObjectType: "table",
+				Object:     match[1],
+				Schema:     nativeError.Schema,
+				DbError:    newDbError(nativeError),
+			}
+		}
+
+		permissionDeniedError := &dberrors.PermissionDeniedError{
+			Schema:  nativeError.Schema,
+			DbError: newDbError(nativeError),
+		}
+
+		if match := permissionErrorObjectRe.FindStringSubmatch(nativeError.Message); match != nil {
+			permissionDeniedError.ObjectType = match[1]
+			permissionDeniedError.Object = match[2]
+
+			// Postgres < 11 reports tables as relations
+			if permissionDeniedError.ObjectType == "relation" {
+				permissionDeniedError.ObjectType = "table"
+			}
+		}
+
+		return permissionDeniedError
+	}
+
+	// invalid_authorization_specification, invalid_password
+	if nativeError.Code.Class() == "28" {
+		authenticationError := &dberrors.AuthenticationError{
+			DbError: newDbError(nativeError),
+		}
+
+		if match := authenticationErrorUserRe.FindStringSubmatch(nativeError.Message); match != nil {
+			authenticationError.User = match[1]
+		}
+
+		return authenticationError
+	}
+
+	return nil
+}
+
+var undefinedObjectErrorColumnRe = regexp.MustCompile(`column "?(?:[^".]+\.)?([^"]+)"? (?:of relation "(.+)" )?does not exist`)
+var undefinedObjectErrorFunctionRe = regexp.MustCompile(`function (.+)\(.*\) does not exist`)
+var undefinedObjectErrorRe = regexp.MustCompile(`(\w+) "(.+)" does not exist`)
+
+func undefinedObjectError(nativeError *pq.Error) error {
+	switch nativeError.Code {
+	// undefined_table
+	case "42P01":
+		return newUndefinedObjectError(nativeError, "table", undefinedObjectErrorRe, 2)
+	// undefined_column
+	case "42703":
+		return newUndefinedObjectError(nativeError, "column", undefinedObjectErrorColumnRe, 1)
+	// undefined_function
+	case "42883":
+		return newUndefinedObjectError(nativeError, "function", undefinedObjectErrorFunctionRe, 1)
+	// invalid_schema_name
+	case "3F000":
+		return newUndefinedObjectError(nativeError, "schema", undefinedObjectErrorRe, 2)
+	// undefined_object, e.g. types, roles and constraints
+	case "42704":
+		undefinedObjectError := newUndefinedObjectError(nativeError, "", undefinedObjectErrorRe, 2)
+		if match := undefinedObjectErrorRe.FindStringSubmatch(nativeError.Message); match != nil {
+			undefinedObjectError.ObjectType = match[1]
+		}
+
+		return undefinedObjectError
+	}
+
+	return nil
+}
+
+func newUndefinedObjectError(nativeError *pq.Error, objectType string, re *regexp.Regexp, group int) *dberrors.UndefinedObjectError {
+	undefinedObjectError := &dberrors.UndefinedObjectError{
+		ObjectType: objectType,
+		DbError:    newDbError(nativeError),
+	}
+
+	if match := re.FindStringSubmatch(nativeError.Message); match != nil {
+		undefinedObjectError.Name = match[group]
+	}
+
+	return undefinedObjectError
+}
+
+var syntaxErrorTokenRe = regexp.MustCompile(`at or near "(.*)"`)
+
+func syntaxError(nativeError *pq.Error) error {
+	// syntax_error
+	if nativeError.Code == "42601" {
+		// The line is left unset, postgres reports the character offset in the query but not the query itself
+		syntaxError := &dberrors.SyntaxError{
+			Position: Diagnostics(nativeError).Position,
+			DbError:  newDbError(nativeError),
+		}
+
+		// "syntax error at end of input" has no token
+		if match := syntaxErrorTokenRe.FindStringSubmatch(nativeError.Message); match != nil {
+			syntaxError.Token = match[1]
 		}
+
+		return syntaxError
 	}
 
 	return nil
 }
 
+func resourceExhaustedError(nativeError *pq.Error) error {
+	// insufficient_resources
+	if nativeError.Code.Class() == "53" {
+		resourceExhaustedError := &dberrors.ResourceExhaustedError{
+			DbError: newDbError(nativeError),
+		}
+
+		switch nativeError.Code {
+		// disk_full
+		case "53100":
+			resourceExhaustedError.Resource = "disk"
+		// out_of_memory
+		case "53200":
+			resourceExhaustedError.Resource = "memory"
+		// too_many_connections
+		case "53300":
+			resourceExhaustedError.Resource = "connections"
+		}
+
+		return resourceExhaustedError
+	}
+
+	return nil
+}
+
+func readOnlyError(nativeError *pq.Error) error {
+	// read_only_sql_transaction
+	if nativeError.Code == "25006" {
+		return &dberrors.ReadOnlyError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func abortedTransactionError(nativeError *pq.Error) error {
+	// in_failed_sql_transaction
+	if nativeError.Code == "25P02" {
+		return &dberrors.AbortedTransactionError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func applicationError(nativeError *pq.Error) error {
+	// raise_exception, the default of RAISE EXCEPTION
+	// assert_failure
+	if nativeError.Code == "P0001" || nativeError.Code == "P0004" {
+		return &dberrors.ApplicationError{
+			Code:    string(nativeError.Code),
+			Message: nativeError.Message,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+// Classes of the SQLSTATE codes raised by postgres itself
+var builtinClasses = map[pq.ErrorClass]bool{
+	"00": true, "01": true, "02": true, "03": true, "08": true, "09": true, "0A": true, "0B": true, "0F": true,
+	"0L": true, "0P": true, "0Z": true, "20": true, "21": true, "22": true, "23": true, "24": true, "25": true,
+	"26": true, "27": true, "28": true, "2B": true, "2D": true, "2F": true, "34": true, "38": true, "39": true,
+	"3B": true, "3D": true, "3F": true, "40": true, "42": true, "44": true, "53": true, "54": true, "55": true,
+	"57": true, "58": true, "72": true, "F0": true, "HV": true, "P0": true, "XX": true,
+}
+
+func customCodeError(nativeError *pq.Error) error {
+	// Codes outside the built-in classes can only be raised with a custom ERRCODE.
+	// Built-in codes added by postgres versions newer than pq are left unclassified.
+	if !builtinClasses[nativeError.Code.Class()] {
+		return &dberrors.ApplicationError{
+			Code:    string(nativeError.Code),
+			Message: nativeError.Message,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+// Diagnostics Diagnostics export
+func Diagnostics(err error) dberrors.Diagnostics {
+	var nativeError *pq.Error
+	if !errors.As(err, &nativeError) {
+		return dberrors.Diagnostics{}
+	}
+
+	// Positions and line numbers are reported as strings, missing values are left as 0
+	position, _ := strconv.Atoi(nativeError.Position)
+	internalPosition, _ := strconv.Atoi(nativeError.InternalPosition)
+	line, _ := strconv.Atoi(nativeError.Line)
+
+	return dberrors.Diagnostics{
+		Code:             string(nativeError.Code),
+		Severity:         nativeError.Severity,
+		Detail:           nativeError.Detail,
+		Hint:             nativeError.Hint,
+		Position:         position,
+		InternalPosition: internalPosition,
+		InternalQuery:    nativeError.InternalQuery,
+		Where:            nativeError.Where,
+		File:             nativeError.File,
+		Line:             line,
+		Routine:          nativeError.Routine,
+	}
+}
+
+func newDbError(nativeError *pq.Error) dberrors.DbError {
+	return dberrors.NewDbErrorWithDiagnostics(nativeError, dialect.POSTGRES, Diagnostics(nativeError))
+}
+
 func isDataException(nativeError *pq.Error) bool {
 	return nativeError.Code.Class() == "22"
 }
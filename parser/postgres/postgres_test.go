@@ -0,0 +1,234 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniqueViolationError(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		err        *pq.Error
+		constraint string
+		column     string
+	}{{
+		name:       "constraint",
+		err:        &pq.Error{Code: "23505", Table: "users", Constraint: "users_email_key", Detail: "Key (email)=(jane@example.com) already exists."},
+		constraint: "users_email_key",
+		column:     "email",
+	}, {
+		name:       "constraint with unique suffix",
+		err:        &pq.Error{Code: "23505", Table: "users", Constraint: "users_email_key unique", Detail: "Key (email)=(jane@example.com) already exists."},
+		constraint: "users_email_key",
+		column:     "email",
+	}, {
+		name:       "expression index",
+		err:        &pq.Error{Code: "23505", Table: "users", Constraint: "users_lower_email_idx", Detail: "Key (lower(email::text))=(jane@example.com) already exists."},
+		constraint: "users_lower_email_idx",
+		column:     "lower(email::text)",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, &dberrors.UniqueViolationError{
+				Table:      "users",
+				Constraint: tc.constraint,
+				Column:     tc.column,
+				DbError:    newDbError(tc.err),
+			}, Parse(tc.err))
+		})
+	}
+}
+
+func TestConcurrencyError(t *testing.T) {
+	for _, tc := range []struct {
+		code pq.ErrorCode
+		kind dberrors.Kind
+	}{
+		{"40P01", dberrors.KindDeadlock},
+		{"40001", dberrors.KindSerializationFailure},
+		{"55P03", dberrors.KindLockTimeout},
+	} {
+		t.Run(string(tc.code), func(t *testing.T) {
+			parsedErr := Parse(&pq.Error{Code: tc.code})
+
+			assert.Equal(t, tc.kind, dberrors.KindOf(parsedErr))
+			assert.True(t, dberrors.IsRetryable(parsedErr))
+		})
+	}
+}
+
+func TestConnectionError(t *testing.T) {
+	for _, tc := range []struct {
+		code          pq.ErrorCode
+		maybeExecuted bool
+	}{
+		{"08006", true},
+		// sqlclient_unable_to_establish_sqlconnection
+		{"08001", false},
+	} {
+		t.Run(string(tc.code), func(t *testing.T) {
+			nativeError := &pq.Error{Code: tc.code}
+
+			assert.Equal(t, &dberrors.ConnectionError{
+				MaybeExecuted: tc.maybeExecuted,
+				DbError:       newDbError(nativeError),
+			}, Parse(nativeError))
+		})
+	}
+}
+
+func TestTimeoutError(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		err      *pq.Error
+		expected func(dberrors.DbError) error
+	}{{
+		name: "statement timeout",
+		err:  &pq.Error{Code: "57014", Message: "canceling statement due to statement timeout"},
+		expected: func(dbError dberrors.DbError) error {
+			return &dberrors.TimeoutError{Source: dberrors.ServerSource, DbError: dbError}
+		},
+	}, {
+		name: "user request",
+		err:  &pq.Error{Code: "57014", Message: "canceling statement due to user request"},
+		expected: func(dbError dberrors.DbError) error {
+			return &dberrors.CanceledError{DbError: dbError}
+		},
+	}, {
+		name: "idle in transaction session timeout",
+		err:  &pq.Error{Code: "25P03", Message: "terminating connection due to idle-in-transaction timeout"},
+		expected: func(dbError dberrors.DbError) error {
+			return &dberrors.TimeoutError{Source: dberrors.ServerSource, DbError: dbError}
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected(newDbError(tc.err)), Parse(tc.err))
+		})
+	}
+}
+
+func TestPermissionError(t *testing.T) {
+	permission := &pq.Error{Code: "42501", Message: "permission denied for table users", Schema: "public"}
+	assert.Equal(t, &dberrors.PermissionDeniedError{
+		ObjectType: "table",
+		Object:     "users",
+		Schema:     "public",
+		DbError:    newDbError(permission),
+	}, Parse(permission))
+
+	authentication := &pq.Error{Code: "28P01", Message: `password authentication failed for user "app"`}
+	assert.Equal(t, &dberrors.AuthenticationError{
+		User:    "app",
+		DbError: newDbError(authentication),
+	}, Parse(authentication))
+}
+
+func TestUndefinedObjectError(t *testing.T) {
+	for _, tc := range []struct {
+		err        *pq.Error
+		objectType string
+		name       string
+	}{
+		{&pq.Error{Code: "42P01", Message: `relation "users" does not exist`}, "table", "users"},
+		{&pq.Error{Code: "42703", Message: `column "emial" of relation "users" does not exist`}, "column", "emial"},
+		{&pq.Error{Code: "42883", Message: "function lower(integer) does not exist"}, "function", "lower"},
+		{&pq.Error{Code: "3F000", Message: `schema "billing" does not exist`}, "schema", "billing"},
+		{&pq.Error{Code: "42704", Message: `type "money2" does not exist`}, "type", "money2"},
+	} {
+		t.Run(tc.err.Message, func(t *testing.T) {
+			assert.Equal(t, &dberrors.UndefinedObjectError{
+				ObjectType: tc.objectType,
+				Name:       tc.name,
+				DbError:    newDbError(tc.err),
+			}, Parse(tc.err))
+		})
+	}
+}
+
+func TestSyntaxError(t *testing.T) {
+	query := "select *\nfrom where"
+	nativeError := &pq.Error{Code: "42601", Message: `syntax error at or near "where"`, Position: "15"}
+
+	parsedErr := Parse(nativeError)
+	assert.Equal(t, &dberrors.SyntaxError{
+		Token:    "where",
+		Position: 15,
+		DbError:  newDbError(nativeError),
+	}, parsedErr)
+
+	// The line is derived from the position
+	assert.Equal(t, "LINE 2: from where\n             ^", parsedErr.(*dberrors.SyntaxError).Caret(query))
+}
+
+func TestResourceExhaustedError(t *testing.T) {
+	for _, tc := range []struct {
+		code     pq.ErrorCode
+		resource string
+	}{
+		{"53100", "disk"},
+		{"53200", "memory"},
+		{"53300", "connections"},
+		// configuration_limit_exceeded
+		{"53400", ""},
+	} {
+		t.Run(string(tc.code), func(t *testing.T) {
+			nativeError := &pq.Error{Code: tc.code}
+
+			assert.Equal(t, &dberrors.ResourceExhaustedError{
+				Resource: tc.resource,
+				DbError:  newDbError(nativeError),
+			}, Parse(nativeError))
+		})
+	}
+}
+
+func TestReadOnlyError(t *testing.T) {
+	nativeError := &pq.Error{Code: "25006", Message: "cannot execute INSERT in a read-only transaction"}
+
+	assert.Equal(t, &dberrors.ReadOnlyError{
+		DbError: newDbError(nativeError),
+	}, Parse(nativeError))
+}
+
+func TestApplicationError(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		err      *pq.Error
+		expected func(dberrors.DbError) error
+	}{{
+		name: "raise exception",
+		err:  &pq.Error{Code: "P0001", Message: "insufficient funds"},
+		expected: func(dbError dberrors.DbError) error {
+			return &dberrors.ApplicationError{Code: "P0001", Message: "insufficient funds", DbError: dbError}
+		},
+	}, {
+		name: "custom code",
+		err:  &pq.Error{Code: "ZZ001", Message: "insufficient funds"},
+		expected: func(dbError dberrors.DbError) error {
+			return &dberrors.ApplicationError{Code: "ZZ001", Message: "insufficient funds", DbError: dbError}
+		},
+	}, {
+		// sql_json_member_not_found, unknown to pq
+		name: "unknown data exception",
+		err:  &pq.Error{Code: "22038", Message: "JSON object does not contain key \"id\""},
+		expected: func(dbError dberrors.DbError) error {
+			return &dberrors.DataError{DbError: dbError}
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected(newDbError(tc.err)), Parse(tc.err))
+		})
+	}
+}
+
+func TestNewerBuiltinCodeIsNotApplicationError(t *testing.T) {
+	// idle_session_timeout (postgres 14), file_name_too_long (postgres 16), unknown to pq
+	for _, code := range []pq.ErrorCode{"57P05", "58P03"} {
+		t.Run(string(code), func(t *testing.T) {
+			assert.Equal(t, "", code.Name())
+			assert.Nil(t, Parse(&pq.Error{Code: code}))
+		})
+	}
+}
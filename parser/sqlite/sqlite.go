@@ -5,15 +5,56 @@ import (
 	"github.com/stackworx-go/dberrors"
 	"github.com/stackworx-go/dberrors/internal/dialect"
 	"regexp"
+	"strconv"
 )
 
+func init() {
+	dberrors.RegisterParser(dialect.SQLITE3, Parse)
+}
+
 // Parse Parse export
 func Parse(err error) error {
-
 	if nativeError, ok := err.(sqlite3.Error); ok {
+		if err := applicationError(nativeError); err != nil {
+			return err
+		}
+
+		// Data errors must be checked before constraint violations, SQLITE_CONSTRAINT_DATATYPE is also a constraint error
+		if err := dataError(nativeError); err != nil {
+			return err
+		}
+
 		if err := constraintViolationError(nativeError); err != nil {
 			return err
 		}
+
+		if err := concurrencyError(nativeError); err != nil {
+			return err
+		}
+
+		if err := timeoutError(nativeError); err != nil {
+			return err
+		}
+
+		if err := permissionError(nativeError); err != nil {
+			return err
+		}
+
+		if err := undefinedObjectError(nativeError); err != nil {
+			return err
+		}
+
+		if err := syntaxError(nativeError); err != nil {
+			return err
+		}
+
+		if err := resourceExhaustedError(nativeError); err != nil {
+			return err
+		}
+
+		if err := readOnlyError(nativeError); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -47,7 +88,7 @@ func uniqueViolationError(nativeError sqlite3.Error) error {
 			return &dberrors.UniqueViolationError{
 				Column:  match[2],
 				Table:   match[1],
-				DbError: dberrors.NewDbError(nativeError, dialect.SQLITE3),
+				DbError: newDbError(nativeError),
 			}
 		}
 	}
@@ -63,7 +104,7 @@ func notNullViolationError(nativeError sqlite3.Error) error {
 			return &dberrors.NotNullViolationError{
 				Table:   match[1],
 				Column:  match[2],
-				DbError: dberrors.NewDbError(nativeError, dialect.SQLITE3),
+				DbError: newDbError(nativeError),
 			}
 		}
 	}
@@ -74,7 +115,7 @@ func notNullViolationError(nativeError sqlite3.Error) error {
 func foreignKeyViolationError(nativeError sqlite3.Error) error {
 	if nativeError.Code == sqlite3.ErrConstraint && nativeError.ExtendedCode == 787 {
 		return &dberrors.ForeignKeyViolationError{
-			DbError: dberrors.NewDbError(nativeError, dialect.SQLITE3),
+			DbError: newDbError(nativeError),
 		}
 	}
 
@@ -84,9 +125,219 @@ func foreignKeyViolationError(nativeError sqlite3.Error) error {
 func checkViolationError(nativeError sqlite3.Error) error {
 	if nativeError.Code == sqlite3.ErrConstraint {
 		return &dberrors.CheckViolationError{
-			DbError: dberrors.NewDbError(nativeError, dialect.SQLITE3),
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func concurrencyError(nativeError sqlite3.Error) error {
+	// SQLITE_BUSY_SNAPSHOT - 517
+	// The read transaction of a WAL database is no longer the latest snapshot
+	if nativeError.Code == sqlite3.ErrBusy && nativeError.ExtendedCode == 517 {
+		return &dberrors.SerializationFailureError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	// SQLITE_BUSY - 5
+	// SQLITE_LOCKED - 6
+	// SQLite reports deadlocks as SQLITE_BUSY without invoking the busy handler,
+	// these can not be told apart from an expired busy timeout.
+	if nativeError.Code == sqlite3.ErrBusy || nativeError.Code == sqlite3.ErrLocked {
+		return &dberrors.LockTimeoutError{
+			DbError: newDbError(nativeError),
 		}
 	}
 
 	return nil
 }
+
+func timeoutError(nativeError sqlite3.Error) error {
+	// SQLITE_INTERRUPT - 9
+	// go-sqlite3 interrupts the statement when the context is done
+	if nativeError.Code == sqlite3.ErrInterrupt {
+		return &dberrors.CanceledError{
+			Source:  dberrors.ClientSource,
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func permissionError(nativeError sqlite3.Error) error {
+	// SQLITE_AUTH_USER - 279
+	// Raised by the user authentication extension
+	if nativeError.Code == sqlite3.ErrAuth && nativeError.ExtendedCode == 279 {
+		return &dberrors.AuthenticationError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	// SQLITE_AUTH - 23
+	// Raised when the authorizer callback denies a statement
+	// SQLITE_PERM - 3
+	// Raised when the database file can not be accessed with the requested mode
+	if nativeError.Code == sqlite3.ErrAuth || nativeError.Code == sqlite3.ErrPerm {
+		return &dberrors.PermissionDeniedError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+var undefinedObjectErrorRe = regexp.MustCompile(`no such (table|column|function): (?:(.+)\.)?(.+)$`)
+
+func undefinedObjectError(nativeError sqlite3.Error) error {
+	if nativeError.Code == sqlite3.ErrError {
+		if match := undefinedObjectErrorRe.FindStringSubmatch(nativeError.Error()); match != nil {
+			undefinedObjectError := &dberrors.UndefinedObjectError{
+				ObjectType: match[1],
+				Name:       match[3],
+				DbError:    newDbError(nativeError),
+			}
+
+			// Columns are qualified with the table instead of the schema
+			if undefinedObjectError.ObjectType == "table" {
+				undefinedObjectError.Schema = match[2]
+			}
+
+			return undefinedObjectError
+		}
+	}
+
+	return nil
+}
+
+var syntaxErrorRe = regexp.MustCompile(`near "(.*)": syntax error`)
+
+func syntaxError(nativeError sqlite3.Error) error {
+	if nativeError.Code == sqlite3.ErrError {
+		if match := syntaxErrorRe.FindStringSubmatch(nativeError.Error()); match != nil {
+			return &dberrors.SyntaxError{
+				Token:   match[1],
+				DbError: newDbError(nativeError),
+			}
+		}
+
+		if nativeError.Error() == "incomplete input" {
+			return &dberrors.SyntaxError{
+				DbError: newDbError(nativeError),
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceExhaustedError(nativeError sqlite3.Error) error {
+	// SQLITE_FULL - 13
+	if nativeError.Code == sqlite3.ErrFull {
+		return &dberrors.ResourceExhaustedError{
+			Resource: "disk",
+			DbError:  newDbError(nativeError),
+		}
+	}
+
+	// SQLITE_NOMEM - 7
+	if nativeError.Code == sqlite3.ErrNomem {
+		return &dberrors.ResourceExhaustedError{
+			Resource: "memory",
+			DbError:  newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func readOnlyError(nativeError sqlite3.Error) error {
+	// SQLITE_READONLY - 8
+	if nativeError.Code == sqlite3.ErrReadonly {
+		return &dberrors.ReadOnlyError{
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func applicationError(nativeError sqlite3.Error) error {
+	// SQLITE_CONSTRAINT_TRIGGER - 1811
+	// Raised by RAISE(ABORT|FAIL|ROLLBACK, message) in a trigger
+	if nativeError.Code == sqlite3.ErrConstraint && nativeError.ExtendedCode == 1811 {
+		return &dberrors.ApplicationError{
+			Code:    strconv.Itoa(int(nativeError.ExtendedCode)),
+			Message: nativeError.Error(),
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+var dataErrorDatatypeRe = regexp.MustCompile(`cannot store \w+ value in \w+ column (.+)\.(.+)$`)
+
+func dataError(nativeError sqlite3.Error) error {
+	// SQLITE_CONSTRAINT_DATATYPE - 3091
+	// Raised by STRICT tables (SQLite 3.37+)
+	if nativeError.Code == sqlite3.ErrConstraint && nativeError.ExtendedCode == 3091 {
+		dataError := &dberrors.DataError{
+			Reason:  dberrors.InvalidTextRepresentation,
+			DbError: newDbError(nativeError),
+		}
+
+		if match := dataErrorDatatypeRe.FindStringSubmatch(nativeError.Error()); match != nil {
+			dataError.Table = match[1]
+			dataError.Column = match[2]
+		}
+
+		return dataError
+	}
+
+	if isDataException(nativeError) {
+		return &dberrors.DataError{
+			Reason:  dataErrorReason(nativeError),
+			DbError: newDbError(nativeError),
+		}
+	}
+
+	return nil
+}
+
+func dataErrorReason(nativeError sqlite3.Error) dberrors.DataErrorReason {
+	switch nativeError.Code {
+	case sqlite3.ErrMismatch:
+		return dberrors.InvalidTextRepresentation
+	case sqlite3.ErrTooBig:
+		return dberrors.StringTruncation
+	}
+
+	return ""
+}
+
+// Diagnostics Diagnostics export
+func Diagnostics(err error) dberrors.Diagnostics {
+	nativeError, ok := err.(sqlite3.Error)
+	if !ok {
+		return dberrors.Diagnostics{}
+	}
+
+	return dberrors.Diagnostics{
+		Code: strconv.Itoa(int(nativeError.ExtendedCode)),
+	}
+}
+
+func newDbError(nativeError sqlite3.Error) dberrors.DbError {
+	return dberrors.NewDbErrorWithDiagnostics(nativeError, dialect.SQLITE3, Diagnostics(nativeError))
+}
+
+func isDataException(nativeError sqlite3.Error) bool {
+	// SQLITE_MISMATCH - 20
+	// SQLITE_TOOBIG - 18
+	// SQLITE_RANGE (25), a bind parameter index out of range, is a programming error and not classified
+	return nativeError.Code == sqlite3.ErrMismatch ||
+		nativeError.Code == sqlite3.ErrTooBig
+}
@@ -0,0 +1,144 @@
+package sqlite
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stretchr/testify/assert"
+)
+
+// exec runs statements against a new in memory database and returns the error of the last one
+func exec(t *testing.T, statements ...string) error {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// Every connection to :memory: opens a new database
+	db.SetMaxOpenConns(1)
+
+	for _, statement := range statements[:len(statements)-1] {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err = db.Exec(statements[len(statements)-1])
+
+	return err
+}
+
+func TestDataError(t *testing.T) {
+	err := exec(t,
+		"create table users (id integer primary key, age integer) strict",
+		"insert into users (age) values ('old')",
+	)
+
+	assert.Equal(t, &dberrors.DataError{
+		Reason:  dberrors.InvalidTextRepresentation,
+		Table:   "users",
+		Column:  "age",
+		DbError: newDbError(err.(sqlite3.Error)),
+	}, Parse(err))
+}
+
+func TestRangeErrorIsNotDataError(t *testing.T) {
+	// A bind parameter index out of range is a programming error
+	assert.Nil(t, Parse(sqlite3.Error{Code: sqlite3.ErrRange}))
+}
+
+func TestConcurrencyError(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  sqlite3.Error
+		kind dberrors.Kind
+	}{
+		{"busy snapshot", sqlite3.Error{Code: sqlite3.ErrBusy, ExtendedCode: 517}, dberrors.KindSerializationFailure},
+		{"busy", sqlite3.Error{Code: sqlite3.ErrBusy, ExtendedCode: 5}, dberrors.KindLockTimeout},
+		{"locked", sqlite3.Error{Code: sqlite3.ErrLocked, ExtendedCode: 6}, dberrors.KindLockTimeout},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			parsedErr := Parse(tc.err)
+
+			assert.Equal(t, tc.kind, dberrors.KindOf(parsedErr))
+			assert.True(t, dberrors.IsRetryable(parsedErr))
+		})
+	}
+}
+
+func TestTimeoutError(t *testing.T) {
+	nativeError := sqlite3.Error{Code: sqlite3.ErrInterrupt, ExtendedCode: 9}
+
+	assert.Equal(t, &dberrors.CanceledError{
+		Source:  dberrors.ClientSource,
+		DbError: newDbError(nativeError),
+	}, Parse(nativeError))
+}
+
+func TestPermissionError(t *testing.T) {
+	authorizer := sqlite3.Error{Code: sqlite3.ErrAuth, ExtendedCode: 23}
+	assert.Equal(t, &dberrors.PermissionDeniedError{
+		DbError: newDbError(authorizer),
+	}, Parse(authorizer))
+
+	authentication := sqlite3.Error{Code: sqlite3.ErrAuth, ExtendedCode: 279}
+	assert.Equal(t, &dberrors.AuthenticationError{
+		DbError: newDbError(authentication),
+	}, Parse(authentication))
+}
+
+func TestUndefinedObjectError(t *testing.T) {
+	for _, tc := range []struct {
+		statements []string
+		expected   *dberrors.UndefinedObjectError
+	}{
+		{[]string{"select * from users"}, &dberrors.UndefinedObjectError{ObjectType: "table", Name: "users"}},
+		{[]string{"create table users (id integer)", "select emial from users"}, &dberrors.UndefinedObjectError{ObjectType: "column", Name: "emial"}},
+		{[]string{"select slugify('a')"}, &dberrors.UndefinedObjectError{ObjectType: "function", Name: "slugify"}},
+	} {
+		t.Run(tc.statements[len(tc.statements)-1], func(t *testing.T) {
+			err := exec(t, tc.statements...)
+
+			tc.expected.DbError = newDbError(err.(sqlite3.Error))
+			assert.Equal(t, tc.expected, Parse(err))
+		})
+	}
+}
+
+func TestSyntaxError(t *testing.T) {
+	err := exec(t, "select * frm users")
+
+	assert.Equal(t, &dberrors.SyntaxError{
+		Token:   "frm",
+		DbError: newDbError(err.(sqlite3.Error)),
+	}, Parse(err))
+}
+
+func TestResourceExhaustedError(t *testing.T) {
+	full := sqlite3.Error{Code: sqlite3.ErrFull, ExtendedCode: 13}
+	assert.Equal(t, &dberrors.ResourceExhaustedError{
+		Resource: "disk",
+		DbError:  newDbError(full),
+	}, Parse(full))
+
+	nomem := sqlite3.Error{Code: sqlite3.ErrNomem, ExtendedCode: 7}
+	assert.Equal(t, &dberrors.ResourceExhaustedError{
+		Resource: "memory",
+		DbError:  newDbError(nomem),
+	}, Parse(nomem))
+}
+
+func TestReadOnlyError(t *testing.T) {
+	err := exec(t,
+		"create table users (id integer)",
+		"pragma query_only = on",
+		"insert into users (id) values (1)",
+	)
+
+	assert.Equal(t, &dberrors.ReadOnlyError{
+		DbError: newDbError(err.(sqlite3.Error)),
+	}, Parse(err))
+}
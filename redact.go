@@ -0,0 +1,232 @@
+package dberrors
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+var showValues int32
+
+// ShowValues ShowValues export
+// Values, such as duplicate keys, are redacted from error messages by default as they may contain personal data.
+// ShowValues(true) includes them in Error(), the messages of unwrapped native errors and Diagnostics().Detail,
+// e.g. for local development.
+func ShowValues(show bool) {
+	var v int32
+	if show {
+		v = 1
+	}
+
+	atomic.StoreInt32(&showValues, v)
+}
+
+// ValuesShown ValuesShown export
+// Reports whether values are shown, see ShowValues.
+func ValuesShown() bool {
+	return valuesShown()
+}
+
+func valuesShown() bool {
+	return atomic.LoadInt32(&showValues) == 1
+}
+
+var redactions = []struct {
+	re          *regexp.Regexp
+	replacement string
+}{
+	// postgres Detail: Key (email)=(jane@example.com) already exists.
+	// Keys of expression indexes contain parentheses, e.g. Key (lower(email::text))=(jane@example.com)
+	{regexp.MustCompile(`Key \(((?:[^()]|\([^()]*\))*)\)=\(.*?\)( already exists| is not present| is still referenced|$)`), "Key ($1)=(?)$2"},
+	// postgres Detail: Failing row contains (1, null).
+	{regexp.MustCompile(`Failing row contains \(.*\)`), "Failing row contains (?)"},
+	// postgres: invalid input syntax for type integer: "abc"
+	{regexp.MustCompile(`(invalid input (?:syntax|value) for (?:type|enum) [^:]+: )".*"`), `$1"?"`},
+	// mysql: Duplicate entry 'jane@example.com' for key 'users.email'
+	{regexp.MustCompile(`Duplicate entry '.*' for key`), "Duplicate entry '?' for key"},
+	// mysql: Incorrect integer value: 'abc' for column 'age' at row 1
+	{regexp.MustCompile(`(Incorrect \w+ value: )'.*'( for column)`), "$1'?'$2"},
+	// mssql: The duplicate key value is (jane@example.com).
+	{regexp.MustCompile(`The duplicate key value is \(.*\)`), "The duplicate key value is (?)"},
+	// mssql: Truncated value: 'abc'.
+	{regexp.MustCompile(`Truncated value: '.*'`), "Truncated value: '?'"},
+}
+
+// Redact Redact export
+// Replaces the values in a database error message with ?, e.g. the Detail of a postgres error:
+//
+//	Key (email)=(jane@example.com) already exists. => Key (email)=(?) already exists.
+func Redact(message string) string {
+	for _, r := range redactions {
+		message = r.re.ReplaceAllString(message, r.replacement)
+	}
+
+	return message
+}
+
+// redactedError hides the values in the message of the native error, as well as any of values.
+// The native error remains accessible with errors.As.
+type redactedError struct {
+	err    error
+	values []string
+}
+
+func (e *redactedError) Error() string {
+	message := e.err.Error()
+	for _, value := range e.values {
+		if value != "" {
+			message = strings.Replace(message, value, "?", -1)
+		}
+	}
+
+	return Redact(message)
+}
+
+func (e *redactedError) Is(target error) bool {
+	return errors.Is(e.err, target)
+}
+
+func (e *redactedError) As(target interface{}) bool {
+	return errors.As(e.err, target)
+}
+
+// native returns the native error, redacted unless values are shown.
+// values are redacted in addition to the values matched by Redact.
+func (e *DbError) native(values ...string) error {
+	if e.err == nil || valuesShown() {
+		return e.err
+	}
+
+	return &redactedError{err: e.err, values: values}
+}
+
+// Unwrap Unwrap implementation
+// The raised message is redacted from the native error unless values are shown.
+func (e *ApplicationError) Unwrap() error {
+	return e.native(e.Message)
+}
+
+// SafeMessage SafeMessage export
+// Returns a user facing message, without identifiers or values, for the first standardized error in err's chain.
+// An empty string is returned when there is none.
+func SafeMessage(err error) string {
+	var safe interface {
+		SafeMessage() string
+	}
+	if errors.As(err, &safe) {
+		return safe.SafeMessage()
+	}
+
+	return ""
+}
+
+// SafeMessage SafeMessage implementation
+func (e *DataError) SafeMessage() string {
+	if message, ok := reasonMessages[e.Reason]; ok {
+		return "invalid data: " + message
+	}
+
+	return "invalid data"
+}
+
+var reasonMessages = map[DataErrorReason]string{
+	StringTruncation:          "value too long",
+	NumericOutOfRange:         "number out of range",
+	InvalidDatetime:           "invalid date or time",
+	InvalidTextRepresentation: "invalid format",
+	DivisionByZero:            "division by zero",
+	InvalidEnumValue:          "invalid enum value",
+	InvalidJSON:               "invalid json",
+}
+
+// SafeMessage SafeMessage implementation
+func (e *CheckViolationError) SafeMessage() string {
+	return "check constraint violated"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *ForeignKeyViolationError) SafeMessage() string {
+	return "referenced record does not exist or is still referenced"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *NotNullViolationError) SafeMessage() string {
+	return "required value missing"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *UniqueViolationError) SafeMessage() string {
+	return "record already exists"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *DeadlockError) SafeMessage() string {
+	return "conflicting concurrent update, please retry"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *SerializationFailureError) SafeMessage() string {
+	return "conflicting concurrent update, please retry"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *LockTimeoutError) SafeMessage() string {
+	return "record is locked, please retry"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *ConnectionError) SafeMessage() string {
+	return "database unavailable"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *TimeoutError) SafeMessage() string {
+	return "database timeout"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *CanceledError) SafeMessage() string {
+	return "database request canceled"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *PermissionDeniedError) SafeMessage() string {
+	return "permission denied"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *AuthenticationError) SafeMessage() string {
+	return "database authentication failed"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *UndefinedObjectError) SafeMessage() string {
+	return "internal database error"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *SyntaxError) SafeMessage() string {
+	return "internal database error"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *ResourceExhaustedError) SafeMessage() string {
+	return "database resources exhausted"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *ReadOnlyError) SafeMessage() string {
+	return "database is read only"
+}
+
+// SafeMessage SafeMessage implementation
+func (e *AbortedTransactionError) SafeMessage() string {
+	return "transaction aborted"
+}
+
+// SafeMessage SafeMessage implementation
+// The message raised by the application may contain values and is not included.
+func (e *ApplicationError) SafeMessage() string {
+	return "application error " + e.Code
+}
@@ -0,0 +1,176 @@
+package dberrors_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/stackworx-go/dberrors"
+	mysqlparser "github.com/stackworx-go/dberrors/parser/mysql"
+	"github.com/stackworx-go/dberrors/parser/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	for _, tc := range []struct {
+		message  string
+		expected string
+	}{{
+		message:  "Key (email)=(jane@example.com) already exists.",
+		expected: "Key (email)=(?) already exists.",
+	}, {
+		message:  "Key (first_name, last_name)=(Jane, Doe) already exists.",
+		expected: "Key (first_name, last_name)=(?) already exists.",
+	}, {
+		message:  "Key (lower(email::text))=(jane@example.com) already exists.",
+		expected: "Key (lower(email::text))=(?) already exists.",
+	}, {
+		message:  `Key (user_id)=(42) is not present in table "users".`,
+		expected: `Key (user_id)=(?) is not present in table "users".`,
+	}, {
+		message:  "Failing row contains (1, jane@example.com, null).",
+		expected: "Failing row contains (?).",
+	}, {
+		message:  `pq: invalid input syntax for type integer: "abc"`,
+		expected: `pq: invalid input syntax for type integer: "?"`,
+	}, {
+		message:  "Error 1062: Duplicate entry 'jane@example.com' for key 'users.email'",
+		expected: "Error 1062: Duplicate entry '?' for key 'users.email'",
+	}, {
+		message:  "Error 1366: Incorrect integer value: 'abc' for column 'age' at row 1",
+		expected: "Error 1366: Incorrect integer value: '?' for column 'age' at row 1",
+	}, {
+		message:  "mssql: Violation of UNIQUE KEY constraint 'users_email_key'. Cannot insert duplicate key in object 'dbo.users'. The duplicate key value is (jane@example.com).",
+		expected: "mssql: Violation of UNIQUE KEY constraint 'users_email_key'. Cannot insert duplicate key in object 'dbo.users'. The duplicate key value is (?).",
+	}, {
+		message:  "mssql: String or binary data would be truncated in table 'db.dbo.users', column 'name'. Truncated value: 'Jane'.",
+		expected: "mssql: String or binary data would be truncated in table 'db.dbo.users', column 'name'. Truncated value: '?'.",
+	}, {
+		message:  "connection refused",
+		expected: "connection refused",
+	}} {
+		assert.Equal(t, tc.expected, dberrors.Redact(tc.message))
+	}
+}
+
+func TestUnwrapRedacted(t *testing.T) {
+	native := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'jane@example.com' for key 'users.email'"}
+
+	err := mysqlparser.Parse(native)
+
+	var uniqueViolationError *dberrors.UniqueViolationError
+	if !assert.True(t, errors.As(err, &uniqueViolationError)) {
+		return
+	}
+
+	assert.Equal(t, "record already exists", uniqueViolationError.SafeMessage())
+	assert.Equal(t, "record already exists", dberrors.SafeMessage(fmt.Errorf("create user: %w", err)))
+	assert.Equal(t, "", dberrors.SafeMessage(errors.New("boom")))
+
+	assert.EqualError(t, errors.Unwrap(uniqueViolationError), "Error 1062: Duplicate entry '?' for key 'users.email'")
+	assert.NotContains(t, fmt.Sprintf("%+v", errors.Unwrap(uniqueViolationError)), "jane@example.com")
+
+	// The native error is available to callers asking for it
+	var mysqlError *mysql.MySQLError
+	if assert.True(t, errors.As(err, &mysqlError)) {
+		assert.Equal(t, native, mysqlError)
+	}
+	assert.True(t, errors.Is(err, native))
+
+	dberrors.ShowValues(true)
+	defer dberrors.ShowValues(false)
+
+	assert.Equal(t, native, errors.Unwrap(uniqueViolationError))
+}
+
+func TestErrorRedacted(t *testing.T) {
+	err := mysqlparser.Parse(&mysql.MySQLError{Number: 1366, Message: "Incorrect integer value: 'jane@example.com' for column 'age' at row 1"})
+
+	assert.EqualError(t, err, "data error Error 1366: Incorrect integer value: '?' for column 'age' at row 1")
+}
+
+func TestDetailRedacted(t *testing.T) {
+	native := &pq.Error{
+		Severity:   "ERROR",
+		Code:       "23505",
+		Message:    `duplicate key value violates unique constraint "users_lower_email_idx"`,
+		Detail:     "Key (lower(email::text))=(jane@example.com) already exists.",
+		Table:      "users",
+		Constraint: "users_lower_email_idx",
+	}
+
+	err := postgres.Parse(native)
+
+	var uniqueViolationError *dberrors.UniqueViolationError
+	if !assert.True(t, errors.As(err, &uniqueViolationError)) {
+		return
+	}
+
+	assert.Equal(t, "Key (lower(email::text))=(?) already exists.", uniqueViolationError.Diagnostics().Detail)
+	assert.NotContains(t, fmt.Sprintf("%+v", err), "jane@example.com")
+
+	data, marshalErr := json.Marshal(err)
+	if assert.NoError(t, marshalErr) {
+		assert.NotContains(t, string(data), "jane@example.com")
+	}
+
+	dberrors.ShowValues(true)
+	defer dberrors.ShowValues(false)
+
+	assert.Equal(t, native.Detail, uniqueViolationError.Diagnostics().Detail)
+}
+
+func TestApplicationMessageRedacted(t *testing.T) {
+	native := &pq.Error{Severity: "ERROR", Code: "P0001", Message: "jane@example.com is blocked"}
+
+	err := postgres.Parse(native)
+
+	var applicationError *dberrors.ApplicationError
+	if !assert.True(t, errors.As(err, &applicationError)) {
+		return
+	}
+
+	data, marshalErr := json.Marshal(err)
+	assert.NoError(t, marshalErr)
+
+	for _, output := range []string{
+		err.Error(),
+		fmt.Sprintf("%v", err),
+		fmt.Sprintf("%+v", err),
+		errors.Unwrap(err).Error(),
+		string(data),
+	} {
+		assert.NotContains(t, output, "jane@example.com")
+	}
+
+	assert.Equal(t, "application error P0001 dialect=POSTGRES code=P0001: pq: ?", fmt.Sprintf("%+v", err))
+
+	// The native error is available to callers asking for it
+	var pqError *pq.Error
+	if assert.True(t, errors.As(err, &pqError)) {
+		assert.Equal(t, native, pqError)
+	}
+
+	dberrors.ShowValues(true)
+	defer dberrors.ShowValues(false)
+
+	data, marshalErr = json.Marshal(err)
+	assert.NoError(t, marshalErr)
+
+	for _, output := range []string{
+		err.Error(),
+		fmt.Sprintf("%+v", err),
+		errors.Unwrap(err).Error(),
+		string(data),
+	} {
+		assert.Contains(t, output, "jane@example.com")
+	}
+
+	decoded, unmarshalErr := dberrors.UnmarshalError(data)
+	if assert.NoError(t, unmarshalErr) {
+		assert.Equal(t, native.Message, decoded.(*dberrors.ApplicationError).Message)
+	}
+}
@@ -0,0 +1,205 @@
+package dberrors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ConstraintRule ConstraintRule export
+// Describes the application error for violations of a constraint.
+type ConstraintRule struct {
+	// Name of the constraint, matched ignoring case and quoting.
+	// Dialects which do not report constraint names (sqlite, not null violations) are matched on "table.column".
+	Constraint string
+	// Only match violations on this table, optional
+	Table string
+	// Only match violations reported by this dialect, optional
+	Dialect Dialect
+	// Field of the request or model the constraint validates
+	Field string
+	// Key of the user facing message, e.g. for translations
+	MessageKey string
+	// Category of the error, e.g. conflict or validation
+	Category string
+	// HTTP status to respond with
+	HTTPStatus int
+}
+
+// ConstraintError ConstraintError export
+// Returned by Registry.Resolve for violations of a registered constraint.
+// Unwraps to the standardized error.
+type ConstraintError struct {
+	Constraint string
+	Table      string
+	Field      string
+	MessageKey string
+	Category   string
+	HTTPStatus int
+	err        error
+}
+
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("constraint error %s: %s", e.Constraint, e.MessageKey)
+}
+
+// Unwrap Unwrap implementation
+func (e *ConstraintError) Unwrap() error {
+	return e.err
+}
+
+// Registry Registry export
+// Maps constraint violations to application errors.
+type Registry struct {
+	mu    sync.RWMutex
+	rules []ConstraintRule
+}
+
+// NewRegistry NewRegistry export
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register Register export
+func (r *Registry) Register(rules ...ConstraintRule) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rules = append(r.rules, rules...)
+}
+
+// Resolve Resolve export
+// Returns a *ConstraintError for violations of a registered constraint, err is classified with Parse.
+// When several rules match, rules restricted to a dialect and table take precedence.
+// err is returned unchanged when no rule matches.
+func (r *Registry) Resolve(err error) error {
+	violation, ok := constraintViolation(Parse(err))
+	if !ok {
+		return err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var match *ConstraintRule
+	best := -1
+
+	for i := range r.rules {
+		rule := &r.rules[i]
+
+		if !violation.matches(rule) {
+			continue
+		}
+
+		score := 0
+		if rule.Dialect != "" {
+			score++
+		}
+		if rule.Table != "" {
+			score++
+		}
+
+		if score > best {
+			match, best = rule, score
+		}
+	}
+
+	if match == nil {
+		return err
+	}
+
+	return &ConstraintError{
+		Constraint: violation.constraint,
+		Table:      violation.table,
+		Field:      match.Field,
+		MessageKey: match.MessageKey,
+		Category:   match.Category,
+		HTTPStatus: match.HTTPStatus,
+		err:        violation.err,
+	}
+}
+
+type violation struct {
+	constraint string
+	table      string
+	dialect    Dialect
+	err        error
+}
+
+func constraintViolation(err error) (violation, bool) {
+	var uniqueViolationError *UniqueViolationError
+	if errors.As(err, &uniqueViolationError) {
+		return newViolation(uniqueViolationError.Constraint, uniqueViolationError.Table, uniqueViolationError.Column, uniqueViolationError.dialect, uniqueViolationError), true
+	}
+
+	var foreignKeyViolationError *ForeignKeyViolationError
+	if errors.As(err, &foreignKeyViolationError) {
+		return newViolation(foreignKeyViolationError.Constraint, foreignKeyViolationError.Table, "", foreignKeyViolationError.dialect, foreignKeyViolationError), true
+	}
+
+	var checkViolationError *CheckViolationError
+	if errors.As(err, &checkViolationError) {
+		return newViolation(checkViolationError.Constraint, checkViolationError.Table, "", checkViolationError.dialect, checkViolationError), true
+	}
+
+	var notNullViolationError *NotNullViolationError
+	if errors.As(err, &notNullViolationError) {
+		return newViolation("", notNullViolationError.Table, notNullViolationError.Column, notNullViolationError.dialect, notNullViolationError), true
+	}
+
+	return violation{}, false
+}
+
+func newViolation(constraint, table, column string, d Dialect, err error) violation {
+	if constraint == "" && table != "" && column != "" {
+		constraint = table + "." + column
+	}
+
+	// MySQL 8 prefixes constraint names with the table
+	if table == "" {
+		if i := strings.LastIndex(constraint, "."); i >= 0 {
+			table = constraint[:i]
+		}
+	}
+
+	return violation{constraint: constraint, table: table, dialect: d, err: err}
+}
+
+func (v violation) matches(rule *ConstraintRule) bool {
+	if rule.Dialect != "" && rule.Dialect != v.dialect {
+		return false
+	}
+
+	if rule.Table != "" && normalizeIdentifier(rule.Table) != normalizeIdentifier(v.table) {
+		return false
+	}
+
+	return constraintMatches(rule.Constraint, v.constraint)
+}
+
+// constraintMatches compares constraint names ignoring case, quoting and a table prefix
+func constraintMatches(expected, actual string) bool {
+	expected = normalizeIdentifier(expected)
+	actual = normalizeIdentifier(actual)
+
+	if expected == "" || actual == "" {
+		return false
+	}
+
+	if expected == actual {
+		return true
+	}
+
+	if !strings.Contains(expected, ".") {
+		if i := strings.LastIndex(actual, "."); i >= 0 {
+			return actual[i+1:] == expected
+		}
+	}
+
+	return false
+}
+
+func normalizeIdentifier(identifier string) string {
+	return strings.ToLower(strings.NewReplacer(`"`, "", "`", "", "[", "", "]", "").Replace(strings.TrimSpace(identifier)))
+}
@@ -0,0 +1,100 @@
+package dberrors_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stackworx-go/dberrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistryResolve(t *testing.T) {
+	registry := dberrors.NewRegistry()
+	registry.Register(dberrors.ConstraintRule{
+		Constraint: "users_email_unique",
+		Field:      "email",
+		MessageKey: "email.taken",
+		Category:   "conflict",
+		HTTPStatus: http.StatusConflict,
+	}, dberrors.ConstraintRule{
+		Constraint: "users_email_unique",
+		Dialect:    dberrors.MSSQL,
+		Table:      "users",
+		Field:      "email",
+		MessageKey: "email.taken.mssql",
+		Category:   "conflict",
+		HTTPStatus: http.StatusConflict,
+	}, dberrors.ConstraintRule{
+		Constraint: "users.email",
+		Field:      "email",
+		MessageKey: "email.required",
+		Category:   "validation",
+		HTTPStatus: http.StatusUnprocessableEntity,
+	})
+
+	for _, tc := range []struct {
+		name       string
+		err        error
+		messageKey string
+	}{{
+		name: "postgres",
+		err: &dberrors.UniqueViolationError{
+			Table:      "users",
+			Constraint: "users_email_unique",
+			DbError:    dberrors.NewDbError(errors.New("native"), dberrors.POSTGRES),
+		},
+		messageKey: "email.taken",
+	}, {
+		name: "mysql table prefix and case",
+		err: &dberrors.UniqueViolationError{
+			Constraint: "Users.USERS_EMAIL_UNIQUE",
+			DbError:    dberrors.NewDbError(errors.New("native"), dberrors.MYSQL),
+		},
+		messageKey: "email.taken",
+	}, {
+		name: "dialect and table specific",
+		err: &dberrors.UniqueViolationError{
+			Table:      "users",
+			Schema:     "dbo",
+			Constraint: "users_email_unique",
+			DbError:    dberrors.NewDbError(errors.New("native"), dberrors.MSSQL),
+		},
+		messageKey: "email.taken.mssql",
+	}, {
+		name: "not null",
+		err: &dberrors.NotNullViolationError{
+			Table:   "users",
+			Column:  "email",
+			DbError: dberrors.NewDbError(errors.New("native"), dberrors.SQLITE3),
+		},
+		messageKey: "email.required",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			resolved := registry.Resolve(tc.err)
+
+			var constraintError *dberrors.ConstraintError
+			if assert.True(t, errors.As(resolved, &constraintError)) {
+				assert.Equal(t, tc.messageKey, constraintError.MessageKey)
+				assert.Equal(t, "email", constraintError.Field)
+			}
+
+			// The standardized error is still available
+			assert.True(t, errors.Is(resolved, tc.err))
+		})
+	}
+}
+
+func TestRegistryResolveUnknown(t *testing.T) {
+	registry := dberrors.NewRegistry()
+	registry.Register(dberrors.ConstraintRule{Constraint: "users_email_unique"})
+
+	err := &dberrors.UniqueViolationError{
+		Constraint: "users_username_unique",
+		DbError:    dberrors.NewDbError(errors.New("native"), dberrors.POSTGRES),
+	}
+	assert.Equal(t, err, registry.Resolve(err))
+
+	other := errors.New("other")
+	assert.Equal(t, other, registry.Resolve(other))
+}
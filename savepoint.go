@@ -0,0 +1,61 @@
+package dberrors
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+)
+
+var savepointCounter uint64
+
+// WithSavepoint WithSavepoint export
+// Runs fn inside a savepoint of tx. When fn returns an error the savepoint is rolled back,
+// keeping the transaction usable, and the error is returned.
+// This allows expected errors, such as a unique violation, to be handled without aborting the transaction.
+func WithSavepoint(ctx context.Context, tx *sql.Tx, d Dialect, fn func() error) error {
+	name := fmt.Sprintf("dberrors_savepoint_%d", atomic.AddUint64(&savepointCounter, 1))
+
+	if _, err := tx.ExecContext(ctx, savepointStatement(d, name)); err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_, _ = tx.ExecContext(ctx, rollbackToSavepointStatement(d, name))
+			panic(p)
+		}
+	}()
+
+	if err := fn(); err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, rollbackToSavepointStatement(d, name)); rollbackErr != nil {
+			return fmt.Errorf("failed to roll back to savepoint: %v: %w", rollbackErr, err)
+		}
+
+		return err
+	}
+
+	// MSSQL savepoints can not be released, they are discarded on commit
+	if d == MSSQL {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, fmt.Sprintf("RELEASE SAVEPOINT %s", name))
+	return err
+}
+
+func savepointStatement(d Dialect, name string) string {
+	if d == MSSQL {
+		return fmt.Sprintf("SAVE TRANSACTION %s", name)
+	}
+
+	return fmt.Sprintf("SAVEPOINT %s", name)
+}
+
+func rollbackToSavepointStatement(d Dialect, name string) string {
+	if d == MSSQL {
+		return fmt.Sprintf("ROLLBACK TRANSACTION %s", name)
+	}
+
+	return fmt.Sprintf("ROLLBACK TO SAVEPOINT %s", name)
+}
@@ -0,0 +1,65 @@
+package dberrors_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/parser/sqlite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithSavepointRollsBackOnError(t *testing.T) {
+	db := openSqlite(t)
+	defer db.Close()
+
+	_, err := db.Exec("create table unique_values (value integer unique)")
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+
+	_, err = tx.Exec("insert into unique_values (value) values (1)")
+	assert.NoError(t, err)
+
+	err = dberrors.WithSavepoint(ctx, tx, dberrors.SQLITE3, func() error {
+		if _, err := tx.Exec("insert into counter (value) values (1)"); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec("insert into unique_values (value) values (1)")
+		return err
+	})
+
+	var uniqueViolationError *dberrors.UniqueViolationError
+	assert.True(t, errors.As(sqlite.Parse(err), &uniqueViolationError))
+
+	// The transaction can continue after the expected error
+	_, err = tx.Exec("insert into unique_values (value) values (2)")
+	assert.NoError(t, err)
+	assert.NoError(t, tx.Commit())
+
+	assert.Equal(t, 0, count(t, db))
+}
+
+func TestWithSavepointReleasesOnSuccess(t *testing.T) {
+	db := openSqlite(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+
+	err = dberrors.WithSavepoint(ctx, tx, dberrors.SQLITE3, func() error {
+		_, err := tx.Exec("insert into counter (value) values (1)")
+		return err
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, tx.Commit())
+
+	assert.Equal(t, 1, count(t, db))
+}
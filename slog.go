@@ -0,0 +1,219 @@
+//go:build go1.21
+// +build go1.21
+
+package dberrors
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"strings"
+)
+
+// logValue groups the fields of a standardized error, message is the message of the logged error
+func logValue(e describer, message string) slog.Value {
+	_, fields := e.describe()
+	dbError := e.dbError()
+
+	attrs := []slog.Attr{
+		slog.String("kind", string(e.Kind())),
+		slog.String("message", message),
+	}
+
+	if dbError.dialect != "" {
+		attrs = append(attrs, slog.String("dialect", string(dbError.dialect)))
+	}
+
+	if code := dbError.diagnostics.Code; code != "" {
+		// Only postgres reports SQLSTATE codes, the other dialects report native error numbers
+		if dbError.dialect == POSTGRES {
+			attrs = append(attrs, slog.String("sqlstate", code))
+		} else {
+			attrs = append(attrs, slog.String("code", code))
+		}
+	}
+
+	for _, f := range fields {
+		if reflect.ValueOf(f.value).IsZero() {
+			continue
+		}
+
+		if f.key == "column" {
+			// Postgres reports all columns of a composite key, e.g. "a, b"
+			var columns []string
+			for _, column := range strings.Split(f.value.(string), ",") {
+				columns = append(columns, strings.TrimSpace(column))
+			}
+			attrs = append(attrs, slog.Any("columns", columns))
+			continue
+		}
+
+		attrs = append(attrs, slog.Any(f.key, f.value))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue LogValue implementation
+func (e *DataError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *CheckViolationError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *ForeignKeyViolationError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *NotNullViolationError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *UniqueViolationError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *DeadlockError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *SerializationFailureError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *LockTimeoutError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *ConnectionError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *TimeoutError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *CanceledError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *PermissionDeniedError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *AuthenticationError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *UndefinedObjectError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *SyntaxError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *ResourceExhaustedError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *ReadOnlyError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *AbortedTransactionError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogValue LogValue implementation
+func (e *ApplicationError) LogValue() slog.Value {
+	return logValue(e, e.Error())
+}
+
+// LogHandler LogHandler export
+// Wraps a slog.Handler to log standardized errors found anywhere in the chain of an error attribute as a group,
+// e.g. slog.Any("err", fmt.Errorf("create user: %w", err)). The message is the message of the logged error.
+type LogHandler struct {
+	handler slog.Handler
+}
+
+// NewLogHandler NewLogHandler export
+func NewLogHandler(handler slog.Handler) *LogHandler {
+	return &LogHandler{handler: handler}
+}
+
+// Enabled Enabled implementation
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle Handle implementation
+func (h *LogHandler) Handle(ctx context.Context, record slog.Record) error {
+	expanded := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		expanded.AddAttrs(expandAttr(attr))
+		return true
+	})
+
+	return h.handler.Handle(ctx, expanded)
+}
+
+// WithAttrs WithAttrs implementation
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	expanded := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		expanded[i] = expandAttr(attr)
+	}
+
+	return &LogHandler{handler: h.handler.WithAttrs(expanded)}
+}
+
+// WithGroup WithGroup implementation
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	return &LogHandler{handler: h.handler.WithGroup(name)}
+}
+
+func expandAttr(attr slog.Attr) slog.Attr {
+	switch attr.Value.Kind() {
+	case slog.KindGroup:
+		group := attr.Value.Group()
+		expanded := make([]slog.Attr, len(group))
+		for i, a := range group {
+			expanded[i] = expandAttr(a)
+		}
+
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(expanded...)}
+	case slog.KindAny:
+		err, ok := attr.Value.Any().(error)
+		if !ok {
+			return attr
+		}
+
+		var d describer
+		if errors.As(err, &d) {
+			return slog.Attr{Key: attr.Key, Value: logValue(d, err.Error())}
+		}
+	}
+
+	return attr
+}
@@ -0,0 +1,108 @@
+//go:build go1.21
+// +build go1.21
+
+package dberrors_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/parser/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	err := &dberrors.UniqueViolationError{
+		Table:      "users",
+		Column:     "first_name, last_name",
+		Constraint: "users_name_key",
+		Schema:     "public",
+		DbError:    dberrors.NewDbErrorWithDiagnostics(errors.New("pq: duplicate key value"), dberrors.POSTGRES, dberrors.Diagnostics{Code: "23505"}),
+	}
+
+	logger.Error("create user", slog.Any("err", err))
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, map[string]interface{}{
+		"kind":       "UNIQUE_VIOLATION",
+		"message":    "unique violation error users.users_name_key",
+		"dialect":    "POSTGRES",
+		"sqlstate":   "23505",
+		"schema":     "public",
+		"table":      "users",
+		"columns":    []interface{}{"first_name", "last_name"},
+		"constraint": "users_name_key",
+	}, entry["err"])
+}
+
+func TestLogValueWithoutSQLState(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	err := &dberrors.DeadlockError{
+		DbError: dberrors.NewDbErrorWithDiagnostics(errors.New("Error 1213: Deadlock found"), dberrors.MYSQL, dberrors.Diagnostics{Code: "1213"}),
+	}
+
+	logger.Error("transfer", slog.Any("err", err))
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, map[string]interface{}{
+		"kind":    "DEADLOCK",
+		"message": "deadlock error Error 1213: Deadlock found",
+		"dialect": "MYSQL",
+		"code":    "1213",
+	}, entry["err"])
+}
+
+func TestLogHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(dberrors.NewLogHandler(slog.NewJSONHandler(&buf, nil)))
+
+	err := fmt.Errorf("create order: %w", &dberrors.ForeignKeyViolationError{
+		Table:      "orders",
+		Constraint: "orders_user_id_fkey",
+		DbError:    dberrors.NewDbErrorWithDiagnostics(errors.New("pq: foreign key violation"), dberrors.POSTGRES, dberrors.Diagnostics{Code: "23503"}),
+	})
+
+	logger.With(slog.Any("cause", err)).WithGroup("request").Error("failed", slog.Group("db", slog.Any("err", err)), slog.Any("other", errors.New("boom")))
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	expected := map[string]interface{}{
+		"kind":       "FOREIGN_KEY_VIOLATION",
+		"message":    "create order: foreign key violation error orders.orders_user_id_fkey",
+		"dialect":    "POSTGRES",
+		"sqlstate":   "23503",
+		"table":      "orders",
+		"constraint": "orders_user_id_fkey",
+	}
+
+	assert.Equal(t, expected, entry["cause"])
+	assert.Equal(t, map[string]interface{}{
+		"db":    map[string]interface{}{"err": expected},
+		"other": "boom",
+	}, entry["request"])
+}
+
+func TestLogValueApplicationMessageRedacted(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	err := postgres.Parse(&pq.Error{Severity: "ERROR", Code: "P0001", Message: "jane@example.com is blocked"})
+
+	logger.Error("create user", slog.Any("err", err))
+
+	assert.NotContains(t, buf.String(), "jane@example.com")
+}
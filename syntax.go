@@ -0,0 +1,87 @@
+package dberrors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Caret Caret export
+// Renders the line of query at which the error was detected with a caret below the error position:
+//
+//	LINE 1: select * from where
+//	                      ^
+//
+// query must be the text of the failed statement.
+func (e *SyntaxError) Caret(query string) string {
+	lines := strings.Split(query, "\n")
+	line, column := e.locate(lines)
+
+	text := lines[line]
+	prefix := fmt.Sprintf("LINE %d: ", line+1)
+
+	padding := []rune(strings.Repeat(" ", len(prefix)))
+	for i, r := range []rune(text) {
+		if i >= column {
+			break
+		}
+
+		// Keep tabs so the caret lines up with the rendered text
+		if r == '\t' {
+			padding = append(padding, '\t')
+		} else {
+			padding = append(padding, ' ')
+		}
+	}
+
+	return prefix + text + "\n" + string(padding) + "^"
+}
+
+// locate returns the 0-based line and character column of the error
+func (e *SyntaxError) locate(lines []string) (int, int) {
+	if e.Position > 0 {
+		offset := e.Position - 1
+		for i, text := range lines {
+			length := len([]rune(text))
+			if offset <= length || i == len(lines)-1 {
+				return i, min(offset, length)
+			}
+
+			// Skip the newline
+			offset -= length + 1
+		}
+	}
+
+	if e.Line > 0 && e.Line <= len(lines) {
+		line := e.Line - 1
+		if e.Token == "" {
+			return line, len([]rune(strings.TrimRight(lines[line], " \t\r")))
+		}
+
+		if index := strings.Index(lines[line], e.Token); index >= 0 {
+			return line, len([]rune(lines[line][:index]))
+		}
+
+		return line, 0
+	}
+
+	if e.Token == "" {
+		last := len(lines) - 1
+		return last, len([]rune(strings.TrimRight(lines[last], " \t\r")))
+	}
+
+	for i, text := range lines {
+		if index := strings.Index(text, e.Token); index >= 0 {
+			return i, len([]rune(text[:index]))
+		}
+	}
+
+	return 0, 0
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,46 @@
+package dberrors_test
+
+import (
+	"testing"
+
+	"github.com/stackworx-go/dberrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyntaxErrorCaret(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		err      dberrors.SyntaxError
+		query    string
+		expected string
+	}{{
+		name:     "position",
+		err:      dberrors.SyntaxError{Token: "where", Position: 15},
+		query:    "select * from where",
+		expected: "LINE 1: select * from where\n                      ^",
+	}, {
+		name:     "position on second line",
+		err:      dberrors.SyntaxError{Token: "where", Position: 16},
+		query:    "select *\n\tfrom where x = 1",
+		expected: "LINE 2: \tfrom where x = 1\n        \t     ^",
+	}, {
+		name:     "end of input",
+		err:      dberrors.SyntaxError{Position: 11},
+		query:    "select 1 +",
+		expected: "LINE 1: select 1 +\n                  ^",
+	}, {
+		name:     "line and token",
+		err:      dberrors.SyntaxError{Token: "where", Line: 2},
+		query:    "select *\nfrom where",
+		expected: "LINE 2: from where\n             ^",
+	}, {
+		name:     "token",
+		err:      dberrors.SyntaxError{Token: "frm"},
+		query:    "select *\nfrm t",
+		expected: "LINE 2: frm t\n        ^",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.err.Caret(tc.query))
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package dberrors
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// DefaultMaxAttempts DefaultMaxAttempts export
+const DefaultMaxAttempts = 3
+
+// Backoff Backoff export
+// Returns the delay before the given retry, attempt starts at 1 for the first retry.
+type Backoff func(attempt int) time.Duration
+
+// ExponentialBackoff ExponentialBackoff export
+// Doubles the delay on every attempt up to max and applies full jitter.
+func ExponentialBackoff(base, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		delay := base
+		for i := 1; i < attempt && delay < max; i++ {
+			delay *= 2
+		}
+
+		if delay > max {
+			delay = max
+		}
+
+		if delay <= 0 {
+			return 0
+		}
+
+		return time.Duration(rand.Int63n(int64(delay) + 1))
+	}
+}
+
+// TxOptions TxOptions export
+type TxOptions struct {
+	// Options used to begin every transaction
+	TxOptions *sql.TxOptions
+	// Maximum number of attempts including the first one, defaults to DefaultMaxAttempts
+	MaxAttempts int
+	// Dialect parser used to classify errors, e.g. postgres.Parse, defaults to Parse.
+	// Errors are used as is when the parser does not recognise them.
+	Parse func(err error) error
+	// Delay between attempts, defaults to ExponentialBackoff(10ms, 1s)
+	Backoff Backoff
+	// Called before every retry with the classified error of the failed attempt
+	OnRetry func(attempt int, err error)
+}
+
+// TxError TxError export
+type TxError struct {
+	Attempts int
+	err      error
+}
+
+func (e *TxError) Error() string {
+	return fmt.Sprintf("transaction failed after %d attempt(s): %v", e.Attempts, e.err)
+}
+
+// Unwrap Unwrap implementation
+func (e *TxError) Unwrap() error {
+	return e.err
+}
+
+// RunInTx RunInTx export
+// Runs fn in a transaction which is committed when fn returns nil and rolled back otherwise.
+// The whole transaction is retried while the error is retryable, see IsRetryable,
+// or when the connection was lost before the transaction was committed.
+// The final error is returned as a *TxError holding the classified error and the attempt count.
+func RunInTx(ctx context.Context, db *sql.DB, opts *TxOptions, fn func(*sql.Tx) error) error {
+	if opts == nil {
+		opts = &TxOptions{}
+	}
+
+	maxAttempts := opts.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	backoff := opts.Backoff
+	if backoff == nil {
+		backoff = ExponentialBackoff(10*time.Millisecond, time.Second)
+	}
+
+	for attempt := 1; ; attempt++ {
+		commit, err := runTx(ctx, db, opts.TxOptions, fn)
+
+		if err == nil {
+			return nil
+		}
+
+		err = classify(opts.Parse, err)
+
+		if attempt >= maxAttempts || !isRetryableTx(err, commit) {
+			return &TxError{Attempts: attempt, err: err}
+		}
+
+		if opts.OnRetry != nil {
+			opts.OnRetry(attempt, err)
+		}
+
+		timer := time.NewTimer(backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return &TxError{Attempts: attempt, err: err}
+		case <-timer.C:
+		}
+	}
+}
+
+// runTx reports whether the error was returned by the commit
+func runTx(ctx context.Context, db *sql.DB, txOptions *sql.TxOptions, fn func(*sql.Tx) error) (bool, error) {
+	tx, err := db.BeginTx(ctx, txOptions)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		// The rollback error is dropped in favour of the original error
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return true, err
+	}
+
+	return false, nil
+}
+
+// A lost connection rolls back an uncommitted transaction,
+// the transaction may only have been committed when the commit itself failed.
+// Drivers do not reliably report whether the commit reached the server, e.g. pq returns
+// driver.ErrBadConn when the connection is lost after sending it, so a failed commit is never retried.
+func isRetryableTx(err error, commit bool) bool {
+	var connectionError *ConnectionError
+	if errors.As(err, &connectionError) {
+		return !commit
+	}
+
+	return IsRetryable(err)
+}
+
+func classify(parse func(error) error, err error) error {
+	var parsedErr error
+	if parse == nil {
+		// Parse falls back to the generic classification itself
+		parsedErr = Parse(err)
+	} else if parsedErr = parse(err); parsedErr == nil {
+		// Dialect parsers only recognise the errors of their driver
+		parsedErr = parseGeneric(err, "")
+	}
+
+	if parsedErr != nil {
+		return parsedErr
+	}
+
+	return err
+}
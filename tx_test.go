@@ -0,0 +1,237 @@
+package dberrors_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/stackworx-go/dberrors"
+	"github.com/stackworx-go/dberrors/parser/sqlite"
+	"github.com/stretchr/testify/assert"
+)
+
+func openSqlite(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every connection to :memory: opens a new database
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec("create table counter (value integer)"); err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func count(t *testing.T, db *sql.DB) int {
+	var n int
+	if err := db.QueryRow("select count(*) from counter").Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+
+	return n
+}
+
+func noBackoff(int) time.Duration {
+	return 0
+}
+
+func TestRunInTxCommits(t *testing.T) {
+	db := openSqlite(t)
+	defer db.Close()
+
+	err := dberrors.RunInTx(context.Background(), db, nil, func(tx *sql.Tx) error {
+		_, err := tx.Exec("insert into counter (value) values (1)")
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count(t, db))
+}
+
+func TestRunInTxRetriesRetryableErrors(t *testing.T) {
+	db := openSqlite(t)
+	defer db.Close()
+
+	var retries []int
+	attempts := 0
+
+	err := dberrors.RunInTx(context.Background(), db, &dberrors.TxOptions{
+		Parse:   sqlite.Parse,
+		Backoff: noBackoff,
+		OnRetry: func(attempt int, err error) {
+			var lockTimeoutError *dberrors.LockTimeoutError
+			assert.True(t, errors.As(err, &lockTimeoutError))
+			retries = append(retries, attempt)
+		},
+	}, func(tx *sql.Tx) error {
+		attempts++
+
+		if _, err := tx.Exec("insert into counter (value) values (1)"); err != nil {
+			return err
+		}
+
+		if attempts < 3 {
+			return sqlite3.Error{Code: sqlite3.ErrBusy}
+		}
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, []int{1, 2}, retries)
+	// Failed attempts are rolled back
+	assert.Equal(t, 1, count(t, db))
+}
+
+func TestRunInTxMaxAttempts(t *testing.T) {
+	db := openSqlite(t)
+	defer db.Close()
+
+	attempts := 0
+
+	err := dberrors.RunInTx(context.Background(), db, &dberrors.TxOptions{
+		MaxAttempts: 2,
+		Parse:       sqlite.Parse,
+		Backoff:     noBackoff,
+	}, func(tx *sql.Tx) error {
+		attempts++
+		return sqlite3.Error{Code: sqlite3.ErrBusy}
+	})
+
+	var txError *dberrors.TxError
+	assert.True(t, errors.As(err, &txError))
+	assert.Equal(t, 2, txError.Attempts)
+	assert.Equal(t, 2, attempts)
+
+	var lockTimeoutError *dberrors.LockTimeoutError
+	assert.True(t, errors.As(err, &lockTimeoutError))
+}
+
+func TestRunInTxDoesNotRetryOtherErrors(t *testing.T) {
+	db := openSqlite(t)
+	defer db.Close()
+
+	attempts := 0
+	expected := errors.New("expected")
+
+	err := dberrors.RunInTx(context.Background(), db, &dberrors.TxOptions{
+		Parse:   sqlite.Parse,
+		Backoff: noBackoff,
+	}, func(tx *sql.Tx) error {
+		attempts++
+		return expected
+	})
+
+	var txError *dberrors.TxError
+	assert.True(t, errors.As(err, &txError))
+	assert.Equal(t, 1, txError.Attempts)
+	assert.True(t, errors.Is(err, expected))
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRunInTxRetriesConnectionErrorsBeforeCommit(t *testing.T) {
+	db := openSqlite(t)
+	defer db.Close()
+
+	attempts := 0
+
+	err := dberrors.RunInTx(context.Background(), db, &dberrors.TxOptions{
+		Parse:   sqlite.Parse,
+		Backoff: noBackoff,
+	}, func(tx *sql.Tx) error {
+		attempts++
+
+		if attempts == 1 {
+			return io.ErrUnexpectedEOF
+		}
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRunInTxDefaultsToParse(t *testing.T) {
+	db := openSqlite(t)
+	defer db.Close()
+
+	attempts := 0
+
+	err := dberrors.RunInTx(context.Background(), db, &dberrors.TxOptions{
+		Backoff: noBackoff,
+	}, func(tx *sql.Tx) error {
+		attempts++
+
+		if attempts == 1 {
+			return sqlite3.Error{Code: sqlite3.ErrBusy, ExtendedCode: 517}
+		}
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+// badCommitDriver loses the connection while committing
+type badCommitDriver struct{}
+
+func (badCommitDriver) Open(string) (driver.Conn, error) { return badCommitConn{}, nil }
+
+type badCommitConn struct{}
+
+func (badCommitConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (badCommitConn) Close() error                        { return nil }
+func (badCommitConn) Begin() (driver.Tx, error)           { return badCommitTx{}, nil }
+
+type badCommitTx struct{}
+
+func (badCommitTx) Commit() error   { return driver.ErrBadConn }
+func (badCommitTx) Rollback() error { return nil }
+
+func init() {
+	sql.Register("bad-commit", badCommitDriver{})
+}
+
+func TestRunInTxDoesNotRetryFailedCommit(t *testing.T) {
+	db, err := sql.Open("bad-commit", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	attempts := 0
+
+	err = dberrors.RunInTx(context.Background(), db, &dberrors.TxOptions{
+		Backoff: noBackoff,
+	}, func(tx *sql.Tx) error {
+		attempts++
+		return nil
+	})
+
+	var connectionError *dberrors.ConnectionError
+	assert.True(t, errors.As(err, &connectionError))
+	assert.True(t, connectionError.MaybeExecuted)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := dberrors.ExponentialBackoff(10*time.Millisecond, 50*time.Millisecond)
+
+	for attempt := 1; attempt < 10; attempt++ {
+		delay := backoff(attempt)
+		assert.True(t, delay >= 0)
+		assert.True(t, delay <= 50*time.Millisecond)
+	}
+}
@@ -0,0 +1,55 @@
+package dberrors
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// IgnoreUnique IgnoreUnique export
+// Returns nil when err is a unique violation of one of constraints, or of any constraint when none are given.
+// Any other error is returned unchanged. err is classified with Parse.
+func IgnoreUnique(err error, constraints ...string) error {
+	if err == nil {
+		return nil
+	}
+
+	return ignoreUnique(Parse(err), err, constraints...)
+}
+
+// ignoreUnique returns nil when parsedErr, the classification of err, is a unique violation of one of constraints
+func ignoreUnique(parsedErr, err error, constraints ...string) error {
+	var uniqueViolationError *UniqueViolationError
+	if !errors.As(parsedErr, &uniqueViolationError) {
+		return err
+	}
+
+	if len(constraints) == 0 {
+		return nil
+	}
+
+	for _, constraint := range constraints {
+		if uniqueViolationError.Matches(constraint) {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// GetOrCreate GetOrCreate export
+// Runs create inside a savepoint of tx. When it fails with a unique violation of one of constraints,
+// classified with the parser of d, the savepoint is rolled back and get is run to load the existing row instead.
+// Reports whether the row was created, any other error is returned unchanged.
+func GetOrCreate(ctx context.Context, tx *sql.Tx, d Dialect, create func() error, get func() error, constraints ...string) (bool, error) {
+	err := WithSavepoint(ctx, tx, d, create)
+	if err == nil {
+		return true, nil
+	}
+
+	if ignoreUnique(ParseDialect(err, d), err, constraints...) != nil {
+		return false, err
+	}
+
+	return false, get()
+}
@@ -0,0 +1,122 @@
+package dberrors_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stackworx-go/dberrors"
+	_ "github.com/stackworx-go/dberrors/parser/postgres"
+	_ "github.com/stackworx-go/dberrors/parser/sqlite"
+	"github.com/stretchr/testify/assert"
+)
+
+func openUsers(t *testing.T) *sql.DB {
+	db := openSqlite(t)
+
+	_, err := db.Exec("create table users (id integer primary key autoincrement, email text unique)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.Exec("insert into users (email) values ('a@example.com')")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func TestIgnoreUnique(t *testing.T) {
+	db := openUsers(t)
+	defer db.Close()
+
+	_, err := db.Exec("insert into users (email) values ('a@example.com')")
+	assert.Error(t, err)
+
+	assert.NoError(t, dberrors.IgnoreUnique(err))
+	assert.NoError(t, dberrors.IgnoreUnique(err, "users.email"))
+	assert.NoError(t, dberrors.IgnoreUnique(err, "other", "USERS.EMAIL"))
+	assert.Equal(t, err, dberrors.IgnoreUnique(err, "other"))
+
+	other := errors.New("other")
+	assert.Equal(t, other, dberrors.IgnoreUnique(other))
+	assert.NoError(t, dberrors.IgnoreUnique(nil))
+}
+
+func TestIgnoreUniquePostgresConstraint(t *testing.T) {
+	err := &pq.Error{
+		Code:       "23505",
+		Message:    `duplicate key value violates unique constraint "users_email_key"`,
+		Detail:     "Key (email)=(a@example.com) already exists.",
+		Table:      "users",
+		Constraint: "users_email_key",
+	}
+
+	assert.NoError(t, dberrors.IgnoreUnique(err, "users_email_key"))
+	assert.Equal(t, err, dberrors.IgnoreUnique(err, "other"))
+}
+
+func TestGetOrCreate(t *testing.T) {
+	db := openUsers(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		email   string
+		created bool
+		id      int64
+	}{
+		{"a@example.com", false, 1},
+		{"b@example.com", true, 2},
+	} {
+		t.Run(tc.email, func(t *testing.T) {
+			tx, err := db.BeginTx(ctx, nil)
+			assert.NoError(t, err)
+			defer func() { _ = tx.Rollback() }()
+
+			var id int64
+			created, err := dberrors.GetOrCreate(ctx, tx, dberrors.SQLITE3, func() error {
+				result, err := tx.Exec("insert into users (email) values (?)", tc.email)
+				if err != nil {
+					return err
+				}
+
+				id, err = result.LastInsertId()
+				return err
+			}, func() error {
+				return tx.QueryRow("select id from users where email = ?", tc.email).Scan(&id)
+			}, "users.email")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.created, created)
+			assert.Equal(t, tc.id, id)
+			assert.NoError(t, tx.Commit())
+		})
+	}
+}
+
+func TestGetOrCreateOtherConstraint(t *testing.T) {
+	db := openUsers(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer func() { _ = tx.Rollback() }()
+
+	created, err := dberrors.GetOrCreate(ctx, tx, dberrors.SQLITE3, func() error {
+		_, err := tx.Exec("insert into users (email) values ('a@example.com')")
+		return err
+	}, func() error {
+		t.Fatal("get must not be called")
+		return nil
+	}, "users.username")
+
+	assert.False(t, created)
+	assert.Error(t, err)
+}